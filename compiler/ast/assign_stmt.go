@@ -13,6 +13,7 @@ type AssignStmt struct {
 	RHS      []Expr
 	Token    token.Token
 	TokenPos source.Pos
+	Ellipsis source.Pos // position of '...' after the last LHS (rest target); NoPos if absent
 }
 
 func (s *AssignStmt) stmtNode() {}
@@ -36,5 +37,9 @@ func (s *AssignStmt) String() string {
 		rhs = append(rhs, e.String())
 	}
 
+	if s.Ellipsis.IsValid() {
+		lhs[len(lhs)-1] += "..."
+	}
+
 	return strings.Join(lhs, ", ") + " " + s.Token.String() + " " + strings.Join(rhs, ", ")
 }
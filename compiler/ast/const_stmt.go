@@ -0,0 +1,29 @@
+package ast
+
+import (
+	"github.com/d5/tengo/compiler/source"
+)
+
+// ConstStmt represents a constant declaration: 'const x := expr'.
+type ConstStmt struct {
+	ConstPos source.Pos
+	Ident    *Ident
+	TokenPos source.Pos
+	Value    Expr
+}
+
+func (s *ConstStmt) stmtNode() {}
+
+// Pos returns the position of first character belonging to the node.
+func (s *ConstStmt) Pos() source.Pos {
+	return s.ConstPos
+}
+
+// End returns the position of first character immediately after the node.
+func (s *ConstStmt) End() source.Pos {
+	return s.Value.End()
+}
+
+func (s *ConstStmt) String() string {
+	return "const " + s.Ident.String() + " := " + s.Value.String()
+}
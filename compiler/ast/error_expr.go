@@ -7,6 +7,7 @@ import (
 // ErrorExpr represents an error expression
 type ErrorExpr struct {
 	Expr     Expr
+	Kind     Expr // optional
 	ErrorPos source.Pos
 	LParen   source.Pos
 	RParen   source.Pos
@@ -25,5 +26,9 @@ func (e *ErrorExpr) End() source.Pos {
 }
 
 func (e *ErrorExpr) String() string {
+	if e.Kind != nil {
+		return "error(" + e.Expr.String() + ", " + e.Kind.String() + ")"
+	}
+
 	return "error(" + e.Expr.String() + ")"
 }
@@ -7,7 +7,7 @@ type MapElementLit struct {
 	Key      string
 	KeyPos   source.Pos
 	ColonPos source.Pos
-	Value    Expr
+	Value    Expr // nil for shorthand elements: '{x}'
 }
 
 func (e *MapElementLit) exprNode() {}
@@ -19,9 +19,17 @@ func (e *MapElementLit) Pos() source.Pos {
 
 // End returns the position of first character immediately after the node.
 func (e *MapElementLit) End() source.Pos {
+	if e.Value == nil {
+		return source.Pos(int(e.KeyPos) + len(e.Key))
+	}
+
 	return e.Value.End()
 }
 
 func (e *MapElementLit) String() string {
+	if e.Value == nil {
+		return e.Key
+	}
+
 	return e.Key + ": " + e.Value.String()
 }
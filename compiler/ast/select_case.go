@@ -0,0 +1,65 @@
+package ast
+
+import (
+	"strings"
+
+	"github.com/d5/tengo/compiler/source"
+	"github.com/d5/tengo/compiler/token"
+)
+
+// SelectCase represents a case of a select statement:
+//
+//	case <-ch:       (Chan)
+//	case v := <-ch:  (LHS, Token, Chan)
+//	case ch <- v:    (Chan, Value)
+//	default:         (Chan is nil)
+type SelectCase struct {
+	CasePos source.Pos
+	LHS     Expr
+	Token   token.Token
+	Chan    Expr
+	Arrow   source.Pos
+	Value   Expr
+	Colon   source.Pos
+	Body    []Stmt
+}
+
+// IsDefault returns true if the case is the default case.
+func (c *SelectCase) IsDefault() bool {
+	return c.Chan == nil
+}
+
+// Pos returns the position of first character belonging to the node.
+func (c *SelectCase) Pos() source.Pos {
+	return c.CasePos
+}
+
+// End returns the position of first character immediately after the node.
+func (c *SelectCase) End() source.Pos {
+	if n := len(c.Body); n > 0 {
+		return c.Body[n-1].End()
+	}
+
+	return c.Colon + 1
+}
+
+func (c *SelectCase) String() string {
+	var head string
+	switch {
+	case c.IsDefault():
+		head = "default"
+	case c.Value != nil:
+		head = "case " + c.Chan.String() + " <- " + c.Value.String()
+	case c.LHS != nil:
+		head = "case " + c.LHS.String() + " " + c.Token.String() + " <-" + c.Chan.String()
+	default:
+		head = "case <-" + c.Chan.String()
+	}
+
+	var body []string
+	for _, s := range c.Body {
+		body = append(body, s.String())
+	}
+
+	return head + ": " + strings.Join(body, "; ")
+}
@@ -0,0 +1,36 @@
+package ast
+
+import (
+	"strings"
+
+	"github.com/d5/tengo/compiler/source"
+)
+
+// SelectStmt represents a select statement.
+type SelectStmt struct {
+	SelectPos source.Pos
+	LBrace    source.Pos
+	Cases     []*SelectCase
+	RBrace    source.Pos
+}
+
+func (s *SelectStmt) stmtNode() {}
+
+// Pos returns the position of first character belonging to the node.
+func (s *SelectStmt) Pos() source.Pos {
+	return s.SelectPos
+}
+
+// End returns the position of first character immediately after the node.
+func (s *SelectStmt) End() source.Pos {
+	return s.RBrace + 1
+}
+
+func (s *SelectStmt) String() string {
+	var cases []string
+	for _, c := range s.Cases {
+		cases = append(cases, c.String())
+	}
+
+	return "select { " + strings.Join(cases, "; ") + " }"
+}
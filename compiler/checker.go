@@ -0,0 +1,513 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/d5/tengo/compiler/ast"
+	"github.com/d5/tengo/compiler/source"
+	"github.com/d5/tengo/compiler/token"
+	"github.com/d5/tengo/objects"
+)
+
+// checker resolves the symbols of the AST the same way the compiler does,
+// but it does not generate any instructions.
+type checker struct {
+	file        *source.File
+	symbolTable *SymbolTable
+	loopDepth   int
+}
+
+// Check resolves the symbols of the parsed file without generating the
+// bytecode and returns the first error found along with its position.
+// The symbol table should contain the builtin functions and the global
+// variables that are available to the file. If not (nil), Check will create
+// a new symbol table with the default builtin functions. Imported modules
+// are not loaded or checked.
+func Check(file *ast.File, symbolTable *SymbolTable) error {
+	if symbolTable == nil {
+		symbolTable = NewSymbolTable()
+
+		for idx, fn := range objects.Builtins {
+			symbolTable.DefineBuiltin(idx, fn.Name)
+		}
+	}
+
+	c := &checker{
+		file:        file.InputFile,
+		symbolTable: symbolTable,
+	}
+
+	return c.check(file)
+}
+
+func (c *checker) check(node ast.Node) error {
+	switch node := node.(type) {
+	case *ast.File:
+		return c.checkStmts(node.Stmts)
+
+	case *ast.ExprStmt:
+		return c.check(node.Expr)
+
+	case *ast.IncDecStmt:
+		op := token.AddAssign
+		if node.Token == token.Dec {
+			op = token.SubAssign
+		}
+
+		return c.checkAssign(node, []ast.Expr{node.Expr}, nil, op)
+
+	case *ast.ParenExpr:
+		return c.check(node.Expr)
+
+	case *ast.BinaryExpr:
+		if err := c.check(node.LHS); err != nil {
+			return err
+		}
+
+		return c.check(node.RHS)
+
+	case *ast.UnaryExpr:
+		return c.check(node.Expr)
+
+	case *ast.IfStmt:
+		c.symbolTable = c.symbolTable.Fork(true)
+		defer func() {
+			c.symbolTable = c.symbolTable.Parent(false)
+		}()
+
+		if node.Init != nil {
+			if err := c.check(node.Init); err != nil {
+				return err
+			}
+		}
+
+		if err := c.check(node.Cond); err != nil {
+			return err
+		}
+
+		if err := c.check(node.Body); err != nil {
+			return err
+		}
+
+		if node.Else != nil {
+			return c.check(node.Else)
+		}
+
+	case *ast.ForStmt:
+		c.symbolTable = c.symbolTable.Fork(true)
+		defer func() {
+			c.symbolTable = c.symbolTable.Parent(false)
+		}()
+
+		if node.Init != nil {
+			if err := c.check(node.Init); err != nil {
+				return err
+			}
+		}
+
+		if node.Cond != nil {
+			if err := c.check(node.Cond); err != nil {
+				return err
+			}
+		}
+
+		if err := c.checkLoopBody(node.Body); err != nil {
+			return err
+		}
+
+		if node.Post != nil {
+			return c.check(node.Post)
+		}
+
+	case *ast.ForInStmt:
+		c.symbolTable = c.symbolTable.Fork(true)
+		defer func() {
+			c.symbolTable = c.symbolTable.Parent(false)
+		}()
+
+		if err := c.check(node.Iterable); err != nil {
+			return err
+		}
+
+		if node.Key.Name != "_" {
+			c.symbolTable.Define(node.Key.Name)
+		}
+
+		if node.Value != nil && node.Value.Name != "_" {
+			c.symbolTable.Define(node.Value.Name)
+		}
+
+		return c.checkLoopBody(node.Body)
+
+	case *ast.SelectStmt:
+		return c.checkSelect(node)
+
+	case *ast.BranchStmt:
+		switch node.Token {
+		case token.Break, token.Continue:
+			if c.loopDepth == 0 {
+				return c.errorf(node, "%s statement outside loop", node.Token.String())
+			}
+		default:
+			return c.errorf(node, "unknown branch statement: %s", node.Token.String())
+		}
+
+	case *ast.BlockStmt:
+		return c.checkStmts(node.Stmts)
+
+	case *ast.ConstStmt:
+		name := node.Ident.Name
+		if _, depth, exists := c.symbolTable.Resolve(name); depth == 0 && exists {
+			return c.errorf(node.Ident, "'%s' redeclared in this block", name)
+		}
+
+		c.symbolTable.DefineConst(name)
+
+		return c.check(node.Value)
+
+	case *ast.AssignStmt:
+		if isDestructuring(node) {
+			return c.checkDestructuring(node)
+		}
+
+		return c.checkAssign(node, node.LHS, node.RHS, node.Token)
+
+	case *ast.Ident:
+		if node.Name == "_" {
+			return c.errorf(node, "cannot use _ as value")
+		}
+
+		if _, _, ok := c.symbolTable.Resolve(node.Name); !ok {
+			return c.errorf(node, "undefined variable: %s", node.Name)
+		}
+
+	case *ast.ArrayLit:
+		return c.checkExprs(node.Elements)
+
+	case *ast.MapLit:
+		for _, elt := range node.Elements {
+			if elt.Value == nil {
+				return c.errorf(elt, "missing value for map key '%s'", elt.Key)
+			}
+
+			if err := c.check(elt.Value); err != nil {
+				return err
+			}
+		}
+
+	case *ast.SelectorExpr:
+		return c.check(node.Expr)
+
+	case *ast.IndexExpr:
+		if err := c.check(node.Expr); err != nil {
+			return err
+		}
+
+		return c.check(node.Index)
+
+	case *ast.SliceExpr:
+		if err := c.check(node.Expr); err != nil {
+			return err
+		}
+
+		if node.Low != nil {
+			if err := c.check(node.Low); err != nil {
+				return err
+			}
+		}
+
+		if node.High != nil {
+			return c.check(node.High)
+		}
+
+	case *ast.FuncLit:
+		c.symbolTable = c.symbolTable.Fork(false)
+		defer func() {
+			c.symbolTable = c.symbolTable.Parent(true)
+		}()
+
+		for _, p := range node.Type.Params.List {
+			c.symbolTable.Define(p.Name)
+		}
+
+		return c.check(node.Body)
+
+	case *ast.ReturnStmt:
+		if c.symbolTable.Parent(true) == nil {
+			return c.errorf(node, "return statement outside function")
+		}
+
+		if node.Result != nil {
+			return c.check(node.Result)
+		}
+
+	case *ast.CallExpr:
+		if err := c.check(node.Func); err != nil {
+			return err
+		}
+
+		return c.checkExprs(node.Args)
+
+	case *ast.ErrorExpr:
+		if err := c.check(node.Expr); err != nil {
+			return err
+		}
+
+		if node.Kind != nil {
+			return c.check(node.Kind)
+		}
+
+	case *ast.ImmutableExpr:
+		return c.check(node.Expr)
+
+	case *ast.CondExpr:
+		if err := c.check(node.Cond); err != nil {
+			return err
+		}
+
+		if err := c.check(node.True); err != nil {
+			return err
+		}
+
+		return c.check(node.False)
+	}
+
+	return nil
+}
+
+func (c *checker) checkStmts(stmts []ast.Stmt) error {
+	for _, stmt := range stmts {
+		if err := c.check(stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *checker) checkExprs(exprs []ast.Expr) error {
+	for _, expr := range exprs {
+		if err := c.check(expr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *checker) checkLoopBody(body *ast.BlockStmt) error {
+	c.loopDepth++
+	defer func() {
+		c.loopDepth--
+	}()
+
+	return c.check(body)
+}
+
+func (c *checker) checkAssign(node ast.Node, lhs, rhs []ast.Expr, op token.Token) error {
+	if len(lhs) < len(rhs) {
+		return c.errorf(node, "assignment count error: %d < %d", len(lhs), len(rhs))
+	}
+
+	if len(lhs) > 1 {
+		return c.errorf(node, "tuple assignment not implemented")
+	}
+
+	if isBlank(lhs[0]) {
+		if op != token.Assign && op != token.Define {
+			return c.errorf(lhs[0], "cannot use _ as value")
+		}
+
+		return c.checkExprs(rhs)
+	}
+
+	selectors, err := c.resolveAssignTarget(lhs[0], op)
+	if err != nil {
+		return err
+	}
+
+	// +=, -=, *=, /=
+	if op != token.Assign && op != token.Define {
+		if err := c.check(lhs[0]); err != nil {
+			return err
+		}
+	}
+
+	if err := c.checkExprs(rhs); err != nil {
+		return err
+	}
+
+	return c.checkExprs(selectors)
+}
+
+func (c *checker) checkDestructuring(node *ast.AssignStmt) error {
+	if node.Token != token.Assign && node.Token != token.Define {
+		return c.errorf(node, "invalid operator for destructuring assignment: %s", node.Token.String())
+	}
+
+	var targets []ast.Expr
+	mapLit, isMap := node.LHS[0].(*ast.MapLit)
+	if isMap {
+		if len(node.LHS) > 1 || node.Ellipsis.IsValid() {
+			return c.errorf(node, "invalid map destructuring assignment")
+		}
+
+		for _, elt := range mapLit.Elements {
+			if elt.Value == nil {
+				targets = append(targets, &ast.Ident{Name: elt.Key, NamePos: elt.KeyPos})
+			} else {
+				targets = append(targets, elt.Value)
+			}
+		}
+	} else {
+		for _, expr := range node.LHS {
+			if _, ok := expr.(*ast.MapLit); ok {
+				return c.errorf(expr, "invalid map destructuring assignment")
+			}
+		}
+
+		targets = node.LHS
+	}
+
+	if len(targets) > maxDestructuringTargets {
+		return c.errorf(node, "too many targets in destructuring assignment: %d", len(targets))
+	}
+
+	var selectors []ast.Expr
+	for _, target := range targets {
+		if isBlank(target) {
+			continue
+		}
+
+		sels, err := c.resolveAssignTarget(target, node.Token)
+		if err != nil {
+			return err
+		}
+
+		selectors = append(selectors, sels...)
+	}
+
+	if err := c.check(node.RHS[0]); err != nil {
+		return err
+	}
+
+	return c.checkExprs(selectors)
+}
+
+func (c *checker) checkSelect(node *ast.SelectStmt) error {
+	c.symbolTable = c.symbolTable.Fork(true)
+	defer func() {
+		c.symbolTable = c.symbolTable.Parent(false)
+	}()
+
+	var cases []*ast.SelectCase
+	var defaultCase *ast.SelectCase
+	for _, sc := range node.Cases {
+		if sc.IsDefault() {
+			if defaultCase != nil {
+				return c.errorf(sc, "multiple defaults in select")
+			}
+
+			defaultCase = sc
+			continue
+		}
+
+		cases = append(cases, sc)
+	}
+
+	if len(cases) > 255 {
+		return c.errorf(node, "too many cases in select: %d", len(cases))
+	}
+
+	for _, sc := range cases {
+		if err := c.check(sc.Chan); err != nil {
+			return err
+		}
+
+		if sc.Value != nil {
+			if err := c.check(sc.Value); err != nil {
+				return err
+			}
+		}
+	}
+
+	if defaultCase != nil {
+		cases = append(cases, defaultCase)
+	}
+
+	for _, sc := range cases {
+		if err := c.checkSelectCase(sc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *checker) checkSelectCase(sc *ast.SelectCase) error {
+	c.symbolTable = c.symbolTable.Fork(true)
+	defer func() {
+		c.symbolTable = c.symbolTable.Parent(false)
+	}()
+
+	if sc.LHS != nil && !isBlank(sc.LHS) {
+		selectors, err := c.resolveAssignTarget(sc.LHS, sc.Token)
+		if err != nil {
+			return err
+		}
+
+		if err := c.checkExprs(selectors); err != nil {
+			return err
+		}
+	}
+
+	return c.checkStmts(sc.Body)
+}
+
+// resolveAssignTarget resolves the variable of the assignment target like
+// Compiler.resolveAssignTarget and returns the selector expressions.
+func (c *checker) resolveAssignTarget(expr ast.Expr, op token.Token) ([]ast.Expr, error) {
+	ident, selectors, err := resolveAssignLHS(expr)
+	if err != nil {
+		return nil, c.errorf(expr, "%s", err.Error())
+	}
+
+	if op == token.Define && len(selectors) > 0 {
+		return nil, c.errorf(expr, "cannot use selector with ':='")
+	}
+
+	symbol, depth, exists := c.symbolTable.Resolve(ident)
+	if op == token.Define {
+		if depth == 0 && exists {
+			return nil, c.errorf(expr, "'%s' redeclared in this block", ident)
+		}
+
+		c.symbolTable.Define(ident)
+	} else {
+		if !exists {
+			return nil, c.errorf(expr, "unresolved reference '%s'", ident)
+		}
+
+		if symbol.Const && len(selectors) == 0 {
+			return nil, c.errorf(expr, "cannot assign to constant '%s'", ident)
+		}
+
+		if symbol.Scope == ScopeBuiltin {
+			return nil, c.errorf(expr, "cannot assign to builtin function '%s'", ident)
+		}
+	}
+
+	return selectors, nil
+}
+
+func (c *checker) errorf(node ast.Node, format string, args ...interface{}) error {
+	var pos source.FilePos
+	if c.file != nil {
+		pos = c.file.Position(node.Pos())
+	}
+
+	return &Error{
+		Pos: pos,
+		Msg: fmt.Sprintf(format, args...),
+	}
+}
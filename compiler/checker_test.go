@@ -0,0 +1,76 @@
+package compiler_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/d5/tengo/assert"
+	"github.com/d5/tengo/compiler"
+	"github.com/d5/tengo/compiler/parser"
+	"github.com/d5/tengo/compiler/source"
+)
+
+func TestCheck(t *testing.T) {
+	// valid scripts
+	expectCheck(t, `a := 1; b := a + 2`, "")
+	expectCheck(t, `f := func(x) { y := x; return func() { return x + y } }`, "")
+	expectCheck(t, `for i := 0; i < 3; i++ { if i == 1 { continue }; break }`, "")
+	expectCheck(t, `m := {a: [1, 2]}; for k, v in m { m[k] = v[1:] }`, "")
+	expectCheck(t, `a, b... := [1, 2, 3]; {x, y: z} := {x: a, y: b}`, "")
+	expectCheck(t, `const c := 1; m := {}; m.x += c; m.x--`, "")
+	expectCheck(t, `text := import("text"); e := error("a", "b"); out := immutable([e ? 1 : 2])`, "")
+	expectCheck(t, `ch := channel(); select { case v := <-ch: len(v); default: }`, "")
+	expectCheck(t, `_, b := [1, 2]; _ = b; {x: _} := {x: 1}; for _, v in [b] { _ := v }`, "")
+	expectCheck(t, `count := 0; len := func(x) { return count }; const set := 1`, "")
+	expectCheck(t, `len := 0; len = 5; f := func() { len++ }`, "")
+
+	// invalid scripts
+	expectCheck(t, `a := b`, "test:1:6: undefined variable: b")
+	expectCheck(t, "a := 1\nfunc() {\n  a = c\n}", "test:3:7: undefined variable: c")
+	expectCheck(t, `a := 1; a := 2`, "test:1:9: 'a' redeclared in this block")
+	expectCheck(t, `a = 1`, "test:1:1: unresolved reference 'a'")
+	expectCheck(t, `const a := 1; a = 2`, "test:1:15: cannot assign to constant 'a'")
+	expectCheck(t, `a := {}; a.b := 1`, "test:1:10: cannot use selector with ':='")
+	expectCheck(t, `break`, "test:1:1: break statement outside loop")
+	expectCheck(t, `return 1`, "test:1:1: return statement outside function")
+	expectCheck(t, `if true { a := 1 }; b := a`, "test:1:26: undefined variable: a")
+	expectCheck(t, `for x in [1] {}; out := x`, "test:1:25: undefined variable: x")
+	expectCheck(t, `a := 1; a, b := [1, 2]`, "test:1:9: 'a' redeclared in this block")
+	expectCheck(t, `out := {a}`, "test:1:9: missing value for map key 'a'")
+	expectCheck(t, `ch := channel(); select { case ch <- x: }`, "test:1:38: undefined variable: x")
+	expectCheck(t, `_, b := [1, 2]; c := _`, "test:1:22: cannot use _ as value")
+	expectCheck(t, `_ += 1`, "test:1:1: cannot use _ as value")
+	expectCheck(t, `len = 5`, "test:1:1: cannot assign to builtin function 'len'")
+	expectCheck(t, `len++`, "test:1:1: cannot assign to builtin function 'len'")
+	expectCheck(t, `len += 1`, "test:1:1: cannot assign to builtin function 'len'")
+	expectCheck(t, `len.a = 1`, "test:1:1: cannot assign to builtin function 'len'")
+	expectCheck(t, `f := func() { len = 1 }`, "test:1:15: cannot assign to builtin function 'len'")
+}
+
+func expectCheck(t *testing.T, input string, expected string) {
+	fileSet := source.NewFileSet()
+	file := fileSet.AddFile("test", -1, len(input))
+
+	parsed, err := parser.NewParser(file, []byte(input), nil).ParseFile()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	err = compiler.Check(parsed, nil)
+
+	// the result should be same as the compiler
+	_, _, compileErr := traceCompile(input, nil)
+	assert.Equal(t, compileErr == nil, err == nil, input)
+	if err != nil && compileErr != nil {
+		assert.True(t, strings.HasSuffix(err.Error(), ": "+compileErr.Error()), input)
+	}
+
+	if expected == "" {
+		assert.NoError(t, err, input)
+		return
+	}
+
+	if assert.Error(t, err, input) {
+		assert.Equal(t, expected, err.Error(), input)
+	}
+}
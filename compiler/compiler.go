@@ -1,11 +1,13 @@
 package compiler
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"reflect"
 
 	"github.com/d5/tengo/compiler/ast"
+	"github.com/d5/tengo/compiler/source"
 	"github.com/d5/tengo/compiler/stdlib"
 	"github.com/d5/tengo/compiler/token"
 	"github.com/d5/tengo/objects"
@@ -26,6 +28,10 @@ type Compiler struct {
 	loopIndex       int
 	trace           io.Writer
 	indent          int
+	maxConstants    int
+	maxInstructions int
+	numInstructions int
+	coverFile       *source.File
 }
 
 // NewCompiler creates a Compiler.
@@ -74,6 +80,14 @@ func (c *Compiler) Compile(node ast.Node) error {
 		}
 	}
 
+	if err := c.checkLimits(); err != nil {
+		return err
+	}
+
+	if c.coverFile != nil {
+		c.emitCoverLine(node)
+	}
+
 	switch node := node.(type) {
 	case *ast.File:
 		for _, stmt := range node.Stmts {
@@ -264,6 +278,9 @@ func (c *Compiler) Compile(node ast.Node) error {
 	case *ast.ForInStmt:
 		return c.compileForInStmt(node)
 
+	case *ast.SelectStmt:
+		return c.compileSelectStmt(node)
+
 	case *ast.BranchStmt:
 		if node.Token == token.Break {
 			curLoop := c.currentLoop()
@@ -290,12 +307,28 @@ func (c *Compiler) Compile(node ast.Node) error {
 			}
 		}
 
+	case *ast.ConstStmt:
+		if err := c.compileConst(node); err != nil {
+			return err
+		}
+
 	case *ast.AssignStmt:
+		if isDestructuring(node) {
+			if err := c.compileDestructuring(node.LHS, node.RHS[0], node.Token, node.Ellipsis.IsValid()); err != nil {
+				return err
+			}
+			break
+		}
+
 		if err := c.compileAssign(node.LHS, node.RHS, node.Token); err != nil {
 			return err
 		}
 
 	case *ast.Ident:
+		if node.Name == "_" {
+			return errors.New("cannot use _ as value")
+		}
+
 		symbol, _, ok := c.symbolTable.Resolve(node.Name)
 		if !ok {
 			return fmt.Errorf("undefined variable: %s", node.Name)
@@ -323,6 +356,11 @@ func (c *Compiler) Compile(node ast.Node) error {
 
 	case *ast.MapLit:
 		for _, elt := range node.Elements {
+			if elt.Value == nil {
+				// shorthand elements are only allowed in destructuring
+				return fmt.Errorf("missing value for map key '%s'", elt.Key)
+			}
+
 			// key
 			c.emit(OpConstant, c.addConstant(&objects.String{Value: elt.Key}))
 
@@ -469,7 +507,16 @@ func (c *Compiler) Compile(node ast.Node) error {
 			return err
 		}
 
-		c.emit(OpError)
+		if node.Kind == nil {
+			c.emit(OpError)
+			break
+		}
+
+		if err := c.Compile(node.Kind); err != nil {
+			return err
+		}
+
+		c.emit(OpErrorKind)
 
 	case *ast.ImmutableExpr:
 		if err := c.Compile(node.Expr); err != nil {
@@ -506,7 +553,7 @@ func (c *Compiler) Compile(node ast.Node) error {
 		c.changeOperand(jumpPos2, curPos)
 	}
 
-	return nil
+	return c.checkLimits()
 }
 
 // Bytecode returns a compiled bytecode.
@@ -524,6 +571,20 @@ func (c *Compiler) SetModuleLoader(moduleLoader ModuleLoader) {
 	c.moduleLoader = moduleLoader
 }
 
+// SetMaxConstants sets the maximum number of constants. Compile returns
+// an error if the compiled code, including its modules, has more constants.
+// Zero or negative value means no limit.
+func (c *Compiler) SetMaxConstants(n int) {
+	c.maxConstants = n
+}
+
+// SetMaxInstructions sets the maximum number of instructions. Compile returns
+// an error if the compiled code, including its functions and modules,
+// has more instructions. Zero or negative value means no limit.
+func (c *Compiler) SetMaxInstructions(n int) {
+	c.maxInstructions = n
+}
+
 func (c *Compiler) fork(moduleName string, symbolTable *SymbolTable) *Compiler {
 	child := NewCompiler(symbolTable, c.stdModules, c.trace)
 	child.moduleName = moduleName       // name of the module to compile
@@ -548,6 +609,30 @@ func (c *Compiler) addConstant(o objects.Object) int {
 	return len(c.constants) - 1
 }
 
+// root returns the compiler of the main script.
+func (c *Compiler) root() *Compiler {
+	for c.parent != nil {
+		c = c.parent
+	}
+
+	return c
+}
+
+// checkLimits returns an error if the compiled code exceeds the limits.
+func (c *Compiler) checkLimits() error {
+	r := c.root()
+
+	if r.maxConstants > 0 && len(r.constants) > r.maxConstants {
+		return fmt.Errorf("too many constants: limit is %d", r.maxConstants)
+	}
+
+	if r.maxInstructions > 0 && r.numInstructions > r.maxInstructions {
+		return fmt.Errorf("too many instructions: limit is %d", r.maxInstructions)
+	}
+
+	return nil
+}
+
 func (c *Compiler) addInstruction(b []byte) int {
 	posNewIns := len(c.currentInstructions())
 
@@ -603,6 +688,7 @@ func (c *Compiler) emit(opcode Opcode, operands ...int) int {
 	inst := MakeInstruction(opcode, operands...)
 	pos := c.addInstruction(inst)
 	c.setLastInstruction(opcode, pos)
+	c.root().numInstructions++
 
 	if c.trace != nil {
 		c.printTrace(fmt.Sprintf("EMIT  %s",
@@ -22,30 +22,26 @@ func (c *Compiler) compileAssign(lhs, rhs []ast.Expr, op token.Token) error {
 	//	return fmt.Errorf("invalid operator for tuple assignment: %s", op.String())
 	//}
 
-	// resolve and compile left-hand side
-	ident, selectors, err := resolveAssignLHS(lhs[0])
-	if err != nil {
-		return err
-	}
+	// '_ = x' and '_ := x' discard the value
+	if isBlank(lhs[0]) {
+		if op != token.Assign && op != token.Define {
+			return errors.New("cannot use _ as value")
+		}
 
-	numSel := len(selectors)
+		for _, expr := range rhs {
+			if err := c.Compile(expr); err != nil {
+				return err
+			}
+		}
+		c.emit(OpPop)
 
-	if op == token.Define && numSel > 0 {
-		// using selector on new variable does not make sense
-		return errors.New("cannot use selector with ':='")
+		return nil
 	}
 
-	symbol, depth, exists := c.symbolTable.Resolve(ident)
-	if op == token.Define {
-		if depth == 0 && exists {
-			return fmt.Errorf("'%s' redeclared in this block", ident)
-		}
-
-		symbol = c.symbolTable.Define(ident)
-	} else {
-		if !exists {
-			return fmt.Errorf("unresolved reference '%s'", ident)
-		}
+	// resolve and compile left-hand side
+	symbol, selectors, err := c.resolveAssignTarget(lhs[0], op)
+	if err != nil {
+		return err
 	}
 
 	// +=, -=, *=, /=
@@ -87,6 +83,79 @@ func (c *Compiler) compileAssign(lhs, rhs []ast.Expr, op token.Token) error {
 		c.emit(OpBShiftRight)
 	}
 
+	return c.compileAssignTarget(symbol, selectors, op)
+}
+
+func (c *Compiler) compileConst(node *ast.ConstStmt) error {
+	name := node.Ident.Name
+
+	if _, depth, exists := c.symbolTable.Resolve(name); depth == 0 && exists {
+		return fmt.Errorf("'%s' redeclared in this block", name)
+	}
+
+	symbol := c.symbolTable.DefineConst(name)
+
+	if err := c.Compile(node.Value); err != nil {
+		return err
+	}
+
+	return c.compileAssignTarget(symbol, nil, token.Define)
+}
+
+// isBlank returns true if the expression is the blank identifier '_'.
+func isBlank(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+
+	return ok && ident.Name == "_"
+}
+
+// resolveAssignTarget resolves the variable of the assignment target.
+// For ':=', a new symbol is defined in the current scope.
+func (c *Compiler) resolveAssignTarget(expr ast.Expr, op token.Token) (symbol Symbol, selectors []ast.Expr, err error) {
+	ident, selectors, err := resolveAssignLHS(expr)
+	if err != nil {
+		return
+	}
+
+	if op == token.Define && len(selectors) > 0 {
+		// using selector on new variable does not make sense
+		err = errors.New("cannot use selector with ':='")
+		return
+	}
+
+	symbol, depth, exists := c.symbolTable.Resolve(ident)
+	if op == token.Define {
+		if depth == 0 && exists {
+			err = fmt.Errorf("'%s' redeclared in this block", ident)
+			return
+		}
+
+		symbol = c.symbolTable.Define(ident)
+	} else {
+		if !exists {
+			err = fmt.Errorf("unresolved reference '%s'", ident)
+			return
+		}
+
+		if symbol.Const && len(selectors) == 0 {
+			err = fmt.Errorf("cannot assign to constant '%s'", ident)
+			return
+		}
+
+		if symbol.Scope == ScopeBuiltin {
+			err = fmt.Errorf("cannot assign to builtin function '%s'", ident)
+			return
+		}
+	}
+
+	return
+}
+
+// compileAssignTarget emits the instructions that store the value on top
+// of the stack to the assignment target.
+func (c *Compiler) compileAssignTarget(symbol Symbol, selectors []ast.Expr, op token.Token) error {
+	numSel := len(selectors)
+
 	// compile selector expressions (right to left)
 	for i := numSel - 1; i >= 0; i-- {
 		if err := c.Compile(selectors[i]); err != nil {
@@ -146,6 +215,9 @@ func resolveAssignLHS(expr ast.Expr) (name string, selectors []ast.Expr, err err
 
 	case *ast.Ident:
 		return term.Name, nil, nil
+
+	default:
+		err = fmt.Errorf("invalid assignment target: %s", expr.String())
 	}
 
 	return
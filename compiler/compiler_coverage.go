@@ -0,0 +1,31 @@
+package compiler
+
+import (
+	"github.com/d5/tengo/compiler/ast"
+	"github.com/d5/tengo/compiler/source"
+)
+
+// maxCoverLine is the largest line number that can be recorded.
+const maxCoverLine = 1<<16 - 1
+
+// EnableCoverage makes the compiler emit the instructions that record
+// the source lines of the executed statements. The file should be the
+// source file of the AST to compile. The imported modules are not
+// instrumented.
+func (c *Compiler) EnableCoverage(file *source.File) {
+	c.coverFile = file
+}
+
+// emitCoverLine emits the instruction that records the line of the node
+// if the node is a statement.
+func (c *Compiler) emitCoverLine(node ast.Node) {
+	switch node.(type) {
+	case *ast.BlockStmt, *ast.EmptyStmt, *ast.BadStmt:
+		return
+	case ast.Stmt:
+		line := c.coverFile.Position(node.Pos()).Line
+		if line > 0 && line <= maxCoverLine {
+			c.emit(OpCoverLine, line)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/d5/tengo/compiler/ast"
+	"github.com/d5/tengo/compiler/token"
+	"github.com/d5/tengo/objects"
+)
+
+// maxDestructuringTargets is the maximum number of targets
+// in a single destructuring assignment (1-byte operand).
+const maxDestructuringTargets = 255
+
+// isDestructuring returns true if the assignment statement unpacks
+// a single value into multiple targets ('a, b := arr', 'a, b... := arr')
+// or into map keys ('{x, y} := m').
+func isDestructuring(node *ast.AssignStmt) bool {
+	if len(node.RHS) != 1 {
+		return false
+	}
+
+	if len(node.LHS) > 1 || node.Ellipsis.IsValid() {
+		return true
+	}
+
+	_, isMap := node.LHS[0].(*ast.MapLit)
+
+	return isMap
+}
+
+func (c *Compiler) compileDestructuring(lhs []ast.Expr, rhs ast.Expr, op token.Token, hasRest bool) error {
+	if op != token.Assign && op != token.Define {
+		return fmt.Errorf("invalid operator for destructuring assignment: %s", op.String())
+	}
+
+	var targets []ast.Expr
+	var keys []string
+	mapLit, isMap := lhs[0].(*ast.MapLit)
+	if isMap {
+		if len(lhs) > 1 || hasRest {
+			return errors.New("invalid map destructuring assignment")
+		}
+
+		for _, elt := range mapLit.Elements {
+			keys = append(keys, elt.Key)
+
+			if elt.Value == nil {
+				// shorthand: '{x} := m' binds m.x to x
+				targets = append(targets, &ast.Ident{Name: elt.Key, NamePos: elt.KeyPos})
+			} else {
+				targets = append(targets, elt.Value)
+			}
+		}
+	} else {
+		for _, expr := range lhs {
+			if _, ok := expr.(*ast.MapLit); ok {
+				return errors.New("invalid map destructuring assignment")
+			}
+		}
+
+		targets = lhs
+	}
+
+	if len(targets) > maxDestructuringTargets {
+		return fmt.Errorf("too many targets in destructuring assignment: %d", len(targets))
+	}
+
+	// resolve all targets before compiling RHS (same as single assignment)
+	symbols := make([]Symbol, len(targets))
+	selectors := make([][]ast.Expr, len(targets))
+	for i, target := range targets {
+		if isBlank(target) {
+			continue
+		}
+
+		var err error
+		symbols[i], selectors[i], err = c.resolveAssignTarget(target, op)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := c.Compile(rhs); err != nil {
+		return err
+	}
+
+	if isMap {
+		for _, key := range keys {
+			c.emit(OpConstant, c.addConstant(&objects.String{Value: key}))
+		}
+
+		c.emit(OpUnpackMap, len(keys))
+	} else {
+		rest := 0
+		if hasRest {
+			rest = 1
+		}
+
+		c.emit(OpUnpack, len(targets), rest)
+	}
+
+	// unpacked values are on the stack with the first one on top
+	for i, target := range targets {
+		if isBlank(target) {
+			c.emit(OpPop)
+			continue
+		}
+
+		if err := c.compileAssignTarget(symbols[i], selectors[i], op); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
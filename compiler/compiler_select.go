@@ -0,0 +1,152 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/d5/tengo/compiler/ast"
+	"github.com/d5/tengo/objects"
+)
+
+func (c *Compiler) compileSelectStmt(stmt *ast.SelectStmt) error {
+	c.symbolTable = c.symbolTable.Fork(true)
+	defer func() {
+		c.symbolTable = c.symbolTable.Parent(false)
+	}()
+
+	// select statement is compiled like following:
+	//
+	//   :recv, :sel := select(ch1, v1, isSend1, ch2, v2, isSend2, ...)
+	//   if :sel == 0 {
+	//     ... case 1 ...
+	//   } else if :sel == 1 {
+	//     ... case 2 ...
+	//   } else {
+	//     ... default ...
+	//   }
+	//
+	// ":sel" is the index of chosen case (-1 for default)
+	// and ":recv" is the received value.
+
+	var cases []*ast.SelectCase
+	var defaultCase *ast.SelectCase
+	for _, sc := range stmt.Cases {
+		if sc.IsDefault() {
+			if defaultCase != nil {
+				return errors.New("multiple defaults in select")
+			}
+
+			defaultCase = sc
+			continue
+		}
+
+		cases = append(cases, sc)
+	}
+
+	if len(cases) > 255 {
+		return fmt.Errorf("too many cases in select: %d", len(cases))
+	}
+
+	for _, sc := range cases {
+		if err := c.Compile(sc.Chan); err != nil {
+			return err
+		}
+
+		if sc.Value != nil {
+			if err := c.Compile(sc.Value); err != nil {
+				return err
+			}
+			c.emit(OpTrue)
+		} else {
+			c.emit(OpNull)
+			c.emit(OpFalse)
+		}
+	}
+
+	hasDefault := 0
+	if defaultCase != nil {
+		hasDefault = 1
+	}
+	c.emit(OpSelect, len(cases), hasDefault)
+
+	selSymbol := c.symbolTable.Define(":sel")
+	recvSymbol := c.symbolTable.Define(":recv")
+	c.emitDefineHidden(selSymbol)
+	c.emitDefineHidden(recvSymbol)
+
+	var endJumps []int
+	for i, sc := range cases {
+		c.emitGetHidden(selSymbol)
+		c.emit(OpConstant, c.addConstant(&objects.Int{Value: int64(i)}))
+		c.emit(OpEqual)
+		nextCasePos := c.emit(OpJumpFalsy, 0)
+
+		if err := c.compileSelectCase(sc, recvSymbol); err != nil {
+			return err
+		}
+
+		endJumps = append(endJumps, c.emit(OpJump, 0))
+		c.changeOperand(nextCasePos, len(c.currentInstructions()))
+	}
+
+	if defaultCase != nil {
+		if err := c.compileSelectCase(defaultCase, recvSymbol); err != nil {
+			return err
+		}
+	}
+
+	endPos := len(c.currentInstructions())
+	for _, pos := range endJumps {
+		c.changeOperand(pos, endPos)
+	}
+
+	return nil
+}
+
+func (c *Compiler) compileSelectCase(sc *ast.SelectCase, recvSymbol Symbol) error {
+	c.symbolTable = c.symbolTable.Fork(true)
+	defer func() {
+		c.symbolTable = c.symbolTable.Parent(false)
+	}()
+
+	// case v := <-ch
+	if sc.LHS != nil && !isBlank(sc.LHS) {
+		symbol, selectors, err := c.resolveAssignTarget(sc.LHS, sc.Token)
+		if err != nil {
+			return err
+		}
+
+		c.emitGetHidden(recvSymbol)
+
+		if err := c.compileAssignTarget(symbol, selectors, sc.Token); err != nil {
+			return err
+		}
+	}
+
+	for _, stmt := range sc.Body {
+		if err := c.Compile(stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// emitDefineHidden stores the value on top of the stack to the hidden
+// variable (e.g. ":sel") defined in the current scope.
+func (c *Compiler) emitDefineHidden(symbol Symbol) {
+	if symbol.Scope == ScopeGlobal {
+		c.emit(OpSetGlobal, symbol.Index)
+	} else {
+		c.emit(OpDefineLocal, symbol.Index)
+	}
+}
+
+// emitGetHidden pushes the value of the hidden variable.
+func (c *Compiler) emitGetHidden(symbol Symbol) {
+	if symbol.Scope == ScopeGlobal {
+		c.emit(OpGetGlobal, symbol.Index)
+	} else {
+		c.emit(OpGetLocal, symbol.Index)
+	}
+}
@@ -58,6 +58,11 @@ const (
 	OpIteratorKey                    // Iterator key
 	OpIteratorValue                  // Iterator value
 	OpModule                         // Module
+	OpUnpack                         // Unpack array elements
+	OpUnpackMap                      // Unpack map values
+	OpSelect                         // Select channel operation
+	OpErrorKind                      // Error object with a kind
+	OpCoverLine                      // Record the line coverage
 )
 
 // OpcodeNames is opcode names.
@@ -115,6 +120,11 @@ var OpcodeNames = [...]string{
 	OpIteratorKey:      "ITKEY",
 	OpIteratorValue:    "ITVAL",
 	OpModule:           "MODULE",
+	OpUnpack:           "UNPACK",
+	OpUnpackMap:        "UNPACKMAP",
+	OpSelect:           "SELECT",
+	OpErrorKind:        "ERRKIND",
+	OpCoverLine:        "COVER",
 }
 
 // OpcodeOperands is the number of operands.
@@ -172,6 +182,11 @@ var OpcodeOperands = [...][]int{
 	OpIteratorKey:      {},
 	OpIteratorValue:    {},
 	OpModule:           {2},
+	OpUnpack:           {1, 1},
+	OpUnpackMap:        {1},
+	OpSelect:           {1, 1},
+	OpErrorKind:        {},
+	OpCoverLine:        {2},
 }
 
 // ReadOperands reads operands from the bytecode.
@@ -16,3 +16,31 @@ func TestErrorList_Sort(t *testing.T) {
 	list.Sort()
 	assert.Equal(t, "1:10: error 1 (and 2 more errors)", list.Error())
 }
+
+func TestErrorList_Errors(t *testing.T) {
+	input := []byte("a := 1 + )\nb := 3\nc := [2, 3\nd := 4")
+	fileSet := source.NewFileSet()
+	_, err := parser.ParseFile(fileSet.AddFile("test", -1, len(input)), input, nil)
+	if !assert.Error(t, err) {
+		return
+	}
+
+	list, ok := err.(parser.ErrorList)
+	if !assert.True(t, ok) {
+		return
+	}
+
+	errs := list.Errors()
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, "test:1:10: expected operand, found ')'", errs[0].Error())
+	assert.Equal(t, "test:4:1: expected ']', found d", errs[1].Error())
+	assert.Equal(t, "test:1:10: expected operand, found ')' (and 1 more errors)", err.Error())
+
+	// limit the number of errors
+	p := parser.NewParser(source.NewFileSet().AddFile("test", -1, len(input)), input, nil)
+	p.SetMaxErrors(1)
+	_, err = p.ParseFile()
+	if assert.Error(t, err) {
+		assert.Equal(t, 1, len(err.(parser.ErrorList).Errors()))
+	}
+}
@@ -21,17 +21,23 @@ import (
 	"github.com/d5/tengo/compiler/token"
 )
 
+// DefaultMaxErrors is the default maximum number of errors
+// the parser reports before it stops parsing.
+const DefaultMaxErrors = 10
+
 type bailout struct{}
 
 // Parser parses the Tengo source files.
 type Parser struct {
 	file      *source.File
 	errors    ErrorList
+	maxErrors int
 	scanner   *scanner.Scanner
 	pos       source.Pos
 	token     token.Token
 	tokenLit  string
 	exprLevel int        // < 0: in control clause, >= 0: in expression
+	inSelect  bool       // parsing the channel operation of a select case
 	syncPos   source.Pos // last sync position
 	syncCount int        // number of advance calls without progress
 	trace     bool
@@ -42,9 +48,10 @@ type Parser struct {
 // NewParser creates a Parser.
 func NewParser(file *source.File, src []byte, trace io.Writer) *Parser {
 	p := &Parser{
-		file:     file,
-		trace:    trace != nil,
-		traceOut: trace,
+		file:      file,
+		maxErrors: DefaultMaxErrors,
+		trace:     trace != nil,
+		traceOut:  trace,
 	}
 
 	p.scanner = scanner.NewScanner(p.file, src, func(pos source.FilePos, msg string) {
@@ -56,19 +63,41 @@ func NewParser(file *source.File, src []byte, trace io.Writer) *Parser {
 	return p
 }
 
+// SetMaxErrors sets the maximum number of errors to report. The parser
+// stops parsing when it reaches the limit. Zero or negative value means
+// no limit. Default is DefaultMaxErrors.
+func (p *Parser) SetMaxErrors(n int) {
+	p.maxErrors = n
+}
+
 // ParseFile parses the source and returns an AST file unit.
-func (p *Parser) ParseFile() (*ast.File, error) {
+// If the source has syntax errors, it returns ErrorList of the errors
+// sorted by their positions.
+func (p *Parser) ParseFile() (file *ast.File, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			if _, ok := e.(bailout); !ok {
+				panic(e)
+			}
+		}
+
+		if p.errors.Len() > 0 {
+			p.errors.Sort()
+			file, err = nil, p.errors.Err()
+		}
+	}()
+
 	if p.trace {
 		defer un(trace(p, "File"))
 	}
 
 	if p.errors.Len() > 0 {
-		return nil, p.errors.Err()
+		return
 	}
 
 	stmts := p.parseStmtList()
 	if p.errors.Len() > 0 {
-		return nil, p.errors.Err()
+		return
 	}
 
 	return &ast.File{
@@ -97,9 +126,22 @@ func (p *Parser) parseBinaryExpr(prec1 int) ast.Expr {
 		defer un(trace(p, "BinaryExpression"))
 	}
 
-	x := p.parseUnaryExpr()
+	return p.parseBinaryExprRest(p.parseUnaryExpr(), prec1)
+}
 
+func (p *Parser) parseBinaryExprRest(x ast.Expr, prec1 int) ast.Expr {
 	for {
+		if p.token == token.Arrow && !p.inSelect {
+			// '<-' outside of select case is '<' followed by unary '-'
+			// (e.g. x<-1)
+			if token.Less.Precedence() < prec1 {
+				return x
+			}
+
+			x = p.parseLessNegExpr(x)
+			continue
+		}
+
 		op, prec := p.token, p.token.Precedence()
 		if prec < prec1 {
 			return x
@@ -118,6 +160,24 @@ func (p *Parser) parseBinaryExpr(prec1 int) ast.Expr {
 	}
 }
 
+func (p *Parser) parseLessNegExpr(x ast.Expr) ast.Expr {
+	pos := p.pos
+	p.next()
+
+	y := &ast.UnaryExpr{
+		Token:    token.Sub,
+		TokenPos: pos + 1,
+		Expr:     p.parseUnaryExpr(),
+	}
+
+	return &ast.BinaryExpr{
+		LHS:      x,
+		RHS:      p.parseBinaryExprRest(y, token.Less.Precedence()+1),
+		Token:    token.Less,
+		TokenPos: pos,
+	}
+}
+
 func (p *Parser) parseCondExpr(cond ast.Expr) ast.Expr {
 	questionPos := p.expect(token.Question)
 
@@ -175,7 +235,7 @@ L:
 			default:
 				pos := p.pos
 				p.errorExpected(pos, "selector")
-				p.advance(stmtStart)
+				p.advance(stmtSync)
 				return &ast.BadExpr{From: pos, To: p.pos}
 			}
 		case token.LBrack:
@@ -394,7 +454,7 @@ func (p *Parser) parseOperand() ast.Expr {
 
 	pos := p.pos
 	p.errorExpected(pos, "operand")
-	p.advance(stmtStart)
+	p.advance(stmtSync)
 	return &ast.BadExpr{From: pos, To: p.pos}
 }
 
@@ -407,7 +467,7 @@ func (p *Parser) parseImportExpr() ast.Expr {
 
 	if p.token != token.String {
 		p.errorExpected(p.pos, "module name")
-		p.advance(stmtStart)
+		p.advance(stmtSync)
 		return &ast.BadExpr{From: pos, To: p.pos}
 	}
 
@@ -500,11 +560,19 @@ func (p *Parser) parseErrorExpr() ast.Expr {
 
 	lparen := p.expect(token.LParen)
 	value := p.parseExpr()
+
+	var kind ast.Expr
+	if p.token == token.Comma {
+		p.next()
+		kind = p.parseExpr()
+	}
+
 	rparen := p.expect(token.RParen)
 
 	expr := &ast.ErrorExpr{
 		ErrorPos: pos,
 		Expr:     value,
+		Kind:     kind,
 		LParen:   lparen,
 		RParen:   rparen,
 	}
@@ -566,7 +634,7 @@ func (p *Parser) parseStmtList() (list []ast.Stmt) {
 		defer un(trace(p, "StatementList"))
 	}
 
-	for p.token != token.RBrace && p.token != token.EOF {
+	for p.token != token.RBrace && p.token != token.EOF && p.token != token.Case && p.token != token.Default {
 		list = append(list, p.parseStmt())
 	}
 
@@ -628,6 +696,10 @@ func (p *Parser) parseStmt() (stmt ast.Stmt) {
 		return s
 	case token.Return:
 		return p.parseReturnStmt()
+	case token.Const:
+		return p.parseConstStmt()
+	case token.Select:
+		return p.parseSelectStmt()
 	case token.If:
 		return p.parseIfStmt()
 	case token.For:
@@ -644,11 +716,100 @@ func (p *Parser) parseStmt() (stmt ast.Stmt) {
 	default:
 		pos := p.pos
 		p.errorExpected(pos, "statement")
-		p.advance(stmtStart)
+		p.advance(stmtSync)
 		return &ast.BadStmt{From: pos, To: p.pos}
 	}
 }
 
+func (p *Parser) parseConstStmt() ast.Stmt {
+	if p.trace {
+		defer un(trace(p, "ConstStmt"))
+	}
+
+	pos := p.expect(token.Const)
+	ident := p.parseIdent()
+	tokenPos := p.expect(token.Define)
+	value := p.parseExpr()
+	p.expectSemi()
+
+	return &ast.ConstStmt{
+		ConstPos: pos,
+		Ident:    ident,
+		TokenPos: tokenPos,
+		Value:    value,
+	}
+}
+
+func (p *Parser) parseSelectStmt() ast.Stmt {
+	if p.trace {
+		defer un(trace(p, "SelectStmt"))
+	}
+
+	pos := p.expect(token.Select)
+	lbrace := p.expect(token.LBrace)
+
+	var cases []*ast.SelectCase
+	for p.token == token.Case || p.token == token.Default {
+		cases = append(cases, p.parseSelectCase())
+	}
+
+	rbrace := p.expect(token.RBrace)
+	p.expectSemi()
+
+	return &ast.SelectStmt{
+		SelectPos: pos,
+		LBrace:    lbrace,
+		Cases:     cases,
+		RBrace:    rbrace,
+	}
+}
+
+func (p *Parser) parseSelectCase() *ast.SelectCase {
+	if p.trace {
+		defer un(trace(p, "SelectCase"))
+	}
+
+	c := &ast.SelectCase{CasePos: p.pos}
+
+	if p.token == token.Case {
+		p.next()
+
+		if p.token == token.Arrow { // case <-ch:
+			c.Arrow = p.pos
+			p.next()
+			c.Chan = p.parseExpr()
+		} else {
+			p.inSelect = true
+			x := p.parseExpr()
+			p.inSelect = false
+
+			switch p.token {
+			case token.Arrow: // case ch <- v:
+				c.Chan = x
+				c.Arrow = p.pos
+				p.next()
+				c.Value = p.parseExpr()
+			case token.Assign, token.Define: // case v := <-ch:
+				c.LHS = x
+				c.Token = p.token
+				p.next()
+				c.Arrow = p.expect(token.Arrow)
+				c.Chan = p.parseExpr()
+			default:
+				p.errorExpected(p.pos, "'<-', '=' or ':='")
+				c.Chan = x
+			}
+		}
+	} else {
+		p.expect(token.Default)
+	}
+
+	c.Colon = p.expect(token.Colon)
+	c.Body = p.parseStmtList()
+
+	return c
+}
+
 func (p *Parser) parseForStmt() ast.Stmt {
 	if p.trace {
 		defer un(trace(p, "ForStmt"))
@@ -881,6 +1042,17 @@ func (p *Parser) parseSimpleStmt(forIn bool) ast.Stmt {
 
 	x := p.parseExprList()
 
+	// rest target of destructuring assignment: 'a, b... := arr'
+	var ellipsis source.Pos
+	if p.token == token.Ellipsis {
+		ellipsis = p.pos
+		p.next()
+
+		if p.token != token.Assign && p.token != token.Define {
+			p.errorExpected(p.pos, "'=' or ':='")
+		}
+	}
+
 	switch p.token {
 	case token.Assign, token.Define: // assignment statement
 		pos, tok := p.pos, p.token
@@ -893,6 +1065,7 @@ func (p *Parser) parseSimpleStmt(forIn bool) ast.Stmt {
 			RHS:      y,
 			Token:    tok,
 			TokenPos: pos,
+			Ellipsis: ellipsis,
 		}
 	case token.In:
 		if forIn {
@@ -983,8 +1156,24 @@ func (p *Parser) parseMapElementLit() *ast.MapElementLit {
 		defer un(trace(p, "MapElementLit"))
 	}
 
-	// key: read identifier token but it's not actually an identifier
-	ident := p.parseIdent()
+	// key: read identifier token but it's not actually an identifier,
+	// so the keywords can be used too (e.g. '{default: 1}')
+	var ident *ast.Ident
+	isKeyword := p.token.IsKeyword()
+	if isKeyword {
+		ident = &ast.Ident{NamePos: p.pos, Name: p.tokenLit}
+		p.next()
+	} else {
+		ident = p.parseIdent()
+	}
+
+	// shorthand element '{x, y}' used in map destructuring
+	if !isKeyword && (p.token == token.Comma || p.token == token.RBrace) {
+		return &ast.MapElementLit{
+			Key:    ident.Name,
+			KeyPos: ident.NamePos,
+		}
+	}
 
 	colonPos := p.expect(token.Colon)
 
@@ -1048,7 +1237,7 @@ func (p *Parser) expectSemi() {
 		p.next()
 	default:
 		p.errorExpected(p.pos, "';'")
-		p.advance(stmtStart)
+		p.advance(stmtSync)
 	}
 
 }
@@ -1079,12 +1268,12 @@ func (p *Parser) error(pos source.Pos, msg string) {
 		return
 	}
 
-	if n > 10 {
+	p.errors.Add(filePos, msg)
+
+	if p.maxErrors > 0 && n+1 >= p.maxErrors {
 		// too many errors; terminate early
 		panic(bailout{})
 	}
-
-	p.errors.Add(filePos, msg)
 }
 
 func (p *Parser) errorExpected(pos source.Pos, msg string) {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/d5/tengo/compiler/ast"
+	"github.com/d5/tengo/compiler/source"
 	"github.com/d5/tengo/compiler/token"
 )
 
@@ -129,4 +130,32 @@ func TestAssignment(t *testing.T) {
 				token.MulAssign,
 				p(1, 3)))
 	})
+
+	expect(t, "a, b... := c", func(p pfn) []ast.Stmt {
+		return stmts(
+			&ast.AssignStmt{
+				LHS: exprs(
+					ident("a", p(1, 1)),
+					ident("b", p(1, 4))),
+				RHS:      exprs(ident("c", p(1, 12))),
+				Token:    token.Define,
+				TokenPos: p(1, 9),
+				Ellipsis: p(1, 5),
+			})
+	})
+
+	expect(t, "{a, b: c} = d", func(p pfn) []ast.Stmt {
+		return stmts(
+			assignStmt(
+				exprs(
+					mapLit(p(1, 1), p(1, 9),
+						mapElementLit("a", p(1, 2), source.NoPos, nil),
+						mapElementLit("b", p(1, 5), p(1, 6), ident("c", p(1, 8))))),
+				exprs(ident("d", p(1, 13))),
+				token.Assign,
+				p(1, 11)))
+	})
+
+	expectError(t, "a, b... c = d")
+	expectError(t, "a... += 1")
 }
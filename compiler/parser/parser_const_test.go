@@ -0,0 +1,34 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/compiler/ast"
+	"github.com/d5/tengo/compiler/token"
+)
+
+func TestConst(t *testing.T) {
+	expect(t, "const a := 5", func(p pfn) []ast.Stmt {
+		return stmts(
+			&ast.ConstStmt{
+				ConstPos: p(1, 1),
+				Ident:    ident("a", p(1, 7)),
+				TokenPos: p(1, 9),
+				Value:    intLit(5, p(1, 12)),
+			})
+	})
+
+	expect(t, "const a := b + 1", func(p pfn) []ast.Stmt {
+		return stmts(
+			&ast.ConstStmt{
+				ConstPos: p(1, 1),
+				Ident:    ident("a", p(1, 7)),
+				TokenPos: p(1, 9),
+				Value:    binaryExpr(ident("b", p(1, 12)), intLit(1, p(1, 16)), token.Add, p(1, 14)),
+			})
+	})
+
+	expectError(t, "const a = 5")
+	expectError(t, "const a")
+	expectError(t, "const a, b := 1, 2")
+}
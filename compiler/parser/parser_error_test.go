@@ -39,5 +39,15 @@ func TestError(t *testing.T) {
 					p(1, 13), p(1, 30))))
 	})
 
-	expectError(t, `error()`) // must have a value
+	expect(t, `error("not found", "io")`, func(p pfn) []ast.Stmt {
+		return stmts(
+			exprStmt(
+				errorKindExpr(p(1, 1),
+					stringLit("not found", p(1, 7)),
+					stringLit("io", p(1, 20)),
+					p(1, 6), p(1, 24))))
+	})
+
+	expectError(t, `error()`)              // must have a value
+	expectError(t, `error("a", "b", "c")`) // at most a kind
 }
@@ -58,6 +58,16 @@ func TestMap(t *testing.T) {
 					mapElementLit("key3", p(5, 2), p(5, 6), boolLit(true, p(5, 8))))))
 	})
 
+	// keywords can be the keys
+	expect(t, "{ default: 1, case: 2 }", func(p pfn) []ast.Stmt {
+		return stmts(
+			exprStmt(
+				mapLit(p(1, 1), p(1, 23),
+					mapElementLit("default", p(1, 3), p(1, 10), intLit(1, p(1, 12))),
+					mapElementLit("case", p(1, 15), p(1, 19), intLit(2, p(1, 21))))))
+	})
+
+	expectError(t, `{ default }`)
 	expectError(t, `{ key1: 1, }`)
 	expectError(t, `{
 key1: 1,
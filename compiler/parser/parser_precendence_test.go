@@ -8,4 +8,10 @@ func TestPrecedence(t *testing.T) {
 	expectString(t, `a + b + c`, `((a + b) + c)`)
 	expectString(t, `a + b * c`, `(a + (b * c))`)
 	expectString(t, `x = 2 * 1 + 3 / 4`, `x = ((2 * 1) + (3 / 4))`)
+
+	// '<-' outside of select case is '<' followed by unary '-'
+	expectString(t, `a := x<-1`, `a := (x < (-1))`)
+	expectString(t, `x<-1 + 2`, `(x < ((-1) + 2))`)
+	expectString(t, `x<-1 && y<-2`, `((x < (-1)) && (y < (-2)))`)
+	expectString(t, `a == x<-1`, `((a == x) < (-1))`)
 }
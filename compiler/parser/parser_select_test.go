@@ -0,0 +1,73 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/compiler/ast"
+	"github.com/d5/tengo/compiler/token"
+)
+
+func TestSelect(t *testing.T) {
+	expect(t, "select { case <-a: b }", func(p pfn) []ast.Stmt {
+		return stmts(
+			&ast.SelectStmt{
+				SelectPos: p(1, 1),
+				LBrace:    p(1, 8),
+				Cases: []*ast.SelectCase{
+					{
+						CasePos: p(1, 10),
+						Arrow:   p(1, 15),
+						Chan:    ident("a", p(1, 17)),
+						Colon:   p(1, 18),
+						Body:    stmts(exprStmt(ident("b", p(1, 20)))),
+					},
+				},
+				RBrace: p(1, 22),
+			})
+	})
+
+	expect(t, "select { case v := <-a: case a <- 1: default: }", func(p pfn) []ast.Stmt {
+		return stmts(
+			&ast.SelectStmt{
+				SelectPos: p(1, 1),
+				LBrace:    p(1, 8),
+				Cases: []*ast.SelectCase{
+					{
+						CasePos: p(1, 10),
+						LHS:     ident("v", p(1, 15)),
+						Token:   token.Define,
+						Arrow:   p(1, 20),
+						Chan:    ident("a", p(1, 22)),
+						Colon:   p(1, 23),
+					},
+					{
+						CasePos: p(1, 25),
+						Chan:    ident("a", p(1, 30)),
+						Arrow:   p(1, 32),
+						Value:   intLit(1, p(1, 35)),
+						Colon:   p(1, 36),
+					},
+					{
+						CasePos: p(1, 38),
+						Colon:   p(1, 45),
+					},
+				},
+				RBrace: p(1, 47),
+			})
+	})
+
+	expect(t, "select {}", func(p pfn) []ast.Stmt {
+		return stmts(
+			&ast.SelectStmt{
+				SelectPos: p(1, 1),
+				LBrace:    p(1, 8),
+				RBrace:    p(1, 9),
+			})
+	})
+
+	expectString(t, "select { case a <- b<-1: c }", "select { case a <- (b < (-1)): c }")
+
+	expectError(t, "select { case a: }")
+	expectError(t, "select { case v := a: }")
+	expectError(t, "select { a }")
+}
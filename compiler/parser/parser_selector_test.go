@@ -16,6 +16,17 @@ func TestSelector(t *testing.T) {
 					stringLit("b", p(1, 3)))))
 	})
 
+	// keywords can be the selector names
+	expect(t, "a.select.default", func(p pfn) []ast.Stmt {
+		return stmts(
+			exprStmt(
+				selectorExpr(
+					selectorExpr(
+						ident("a", p(1, 1)),
+						stringLit("select", p(1, 3))),
+					stringLit("default", p(1, 10)))))
+	})
+
 	expect(t, "a.b.c", func(p pfn) []ast.Stmt {
 		return stmts(
 			exprStmt(
@@ -256,6 +256,10 @@ func errorExpr(pos source.Pos, x ast.Expr, lparen, rparen source.Pos) *ast.Error
 	return &ast.ErrorExpr{Expr: x, ErrorPos: pos, LParen: lparen, RParen: rparen}
 }
 
+func errorKindExpr(pos source.Pos, x, kind ast.Expr, lparen, rparen source.Pos) *ast.ErrorExpr {
+	return &ast.ErrorExpr{Expr: x, Kind: kind, ErrorPos: pos, LParen: lparen, RParen: rparen}
+}
+
 func selectorExpr(x, sel ast.Expr) *ast.SelectorExpr {
 	return &ast.SelectorExpr{Expr: x, Sel: sel}
 }
@@ -285,7 +289,18 @@ func equalStmt(t *testing.T, expected, actual ast.Stmt) bool {
 		return equalExprs(t, expected.LHS, actual.(*ast.AssignStmt).LHS) &&
 			equalExprs(t, expected.RHS, actual.(*ast.AssignStmt).RHS) &&
 			assert.Equal(t, int(expected.Token), int(actual.(*ast.AssignStmt).Token)) &&
-			assert.Equal(t, int(expected.TokenPos), int(actual.(*ast.AssignStmt).TokenPos))
+			assert.Equal(t, int(expected.TokenPos), int(actual.(*ast.AssignStmt).TokenPos)) &&
+			assert.Equal(t, int(expected.Ellipsis), int(actual.(*ast.AssignStmt).Ellipsis))
+	case *ast.ConstStmt:
+		return equalExpr(t, expected.Ident, actual.(*ast.ConstStmt).Ident) &&
+			equalExpr(t, expected.Value, actual.(*ast.ConstStmt).Value) &&
+			assert.Equal(t, int(expected.ConstPos), int(actual.(*ast.ConstStmt).ConstPos)) &&
+			assert.Equal(t, int(expected.TokenPos), int(actual.(*ast.ConstStmt).TokenPos))
+	case *ast.SelectStmt:
+		return assert.Equal(t, expected.SelectPos, actual.(*ast.SelectStmt).SelectPos) &&
+			assert.Equal(t, expected.LBrace, actual.(*ast.SelectStmt).LBrace) &&
+			assert.Equal(t, expected.RBrace, actual.(*ast.SelectStmt).RBrace) &&
+			equalSelectCases(t, expected.Cases, actual.(*ast.SelectStmt).Cases)
 	case *ast.IfStmt:
 		return equalStmt(t, expected.Init, actual.(*ast.IfStmt).Init) &&
 			equalExpr(t, expected.Cond, actual.(*ast.IfStmt).Cond) &&
@@ -399,6 +414,7 @@ func equalExpr(t *testing.T, expected, actual ast.Expr) bool {
 			assert.Equal(t, expected.Token, actual.(*ast.ImportExpr).Token)
 	case *ast.ErrorExpr:
 		return equalExpr(t, expected.Expr, actual.(*ast.ErrorExpr).Expr) &&
+			equalExpr(t, expected.Kind, actual.(*ast.ErrorExpr).Kind) &&
 			assert.Equal(t, int(expected.ErrorPos), int(actual.(*ast.ErrorExpr).ErrorPos)) &&
 			assert.Equal(t, int(expected.LParen), int(actual.(*ast.ErrorExpr).LParen)) &&
 			assert.Equal(t, int(expected.RParen), int(actual.(*ast.ErrorExpr).RParen))
@@ -477,3 +493,24 @@ func equalMapElements(t *testing.T, expected, actual []*ast.MapElementLit) bool
 
 	return true
 }
+
+func equalSelectCases(t *testing.T, expected, actual []*ast.SelectCase) bool {
+	if !assert.Equal(t, len(expected), len(actual)) {
+		return false
+	}
+
+	for i := 0; i < len(expected); i++ {
+		if !assert.Equal(t, expected[i].CasePos, actual[i].CasePos) ||
+			!assert.Equal(t, expected[i].Token, actual[i].Token) ||
+			!assert.Equal(t, expected[i].Arrow, actual[i].Arrow) ||
+			!assert.Equal(t, expected[i].Colon, actual[i].Colon) ||
+			!equalExpr(t, expected[i].LHS, actual[i].LHS) ||
+			!equalExpr(t, expected[i].Chan, actual[i].Chan) ||
+			!equalExpr(t, expected[i].Value, actual[i].Value) ||
+			!equalStmts(t, expected[i].Body, actual[i].Body) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -2,10 +2,15 @@ package parser
 
 import "github.com/d5/tengo/compiler/token"
 
-var stmtStart = map[token.Token]bool{
-	token.Break:    true,
-	token.Continue: true,
-	token.For:      true,
-	token.If:       true,
-	token.Return:   true,
+// stmtSync is the set of tokens where the parser resumes parsing after
+// a syntax error: the start or the end of a statement.
+var stmtSync = map[token.Token]bool{
+	token.Break:     true,
+	token.Const:     true,
+	token.Continue:  true,
+	token.For:       true,
+	token.If:        true,
+	token.Return:    true,
+	token.Select:    true,
+	token.Semicolon: true,
 }
@@ -31,6 +31,7 @@ type Scanner struct {
 	readOffset   int          // reading offset (position after current character)
 	lineOffset   int          // current line offset
 	insertSemi   bool         // insert a semicolon before next newline
+	afterPeriod  bool         // the previous token is a period
 	errorHandler ErrorHandler // error reporting; or nil
 	errorCount   int          // number of errors encountered
 	mode         Mode
@@ -76,6 +77,9 @@ func (s *Scanner) Scan() (tok token.Token, literal string, pos source.Pos) {
 	case isLetter(ch):
 		literal = s.scanIdentifier()
 		tok = token.Lookup(literal)
+		if s.afterPeriod {
+			tok = token.Ident // selector names can be keywords (e.g. m.default)
+		}
 		switch tok {
 		case token.Ident, token.Break, token.Continue, token.Return, token.True, token.False, token.Undefined:
 			insertSemi = true
@@ -184,7 +188,12 @@ func (s *Scanner) Scan() (tok token.Token, literal string, pos source.Pos) {
 		case '^':
 			tok = s.switch2(token.Xor, token.XorAssign)
 		case '<':
-			tok = s.switch4(token.Less, token.LessEq, '<', token.Shl, token.ShlAssign)
+			if s.ch == '-' {
+				s.next()
+				tok = token.Arrow
+			} else {
+				tok = s.switch4(token.Less, token.LessEq, '<', token.Shl, token.ShlAssign)
+			}
 		case '>':
 			tok = s.switch4(token.Greater, token.GreaterEq, '>', token.Shr, token.ShrAssign)
 		case '=':
@@ -214,6 +223,7 @@ func (s *Scanner) Scan() (tok token.Token, literal string, pos source.Pos) {
 	if s.mode&DontInsertSemis == 0 {
 		s.insertSemi = insertSemi
 	}
+	s.afterPeriod = tok == token.Period
 
 	return
 }
@@ -87,6 +87,7 @@ func TestScanner_Scan(t *testing.T) {
 		{token.AndNotAssign, "&^="},
 		{token.LAnd, "&&"},
 		{token.LOr, "||"},
+		{token.Arrow, "<-"},
 		{token.Inc, "++"},
 		{token.Dec, "--"},
 		{token.Equal, "=="},
@@ -116,6 +117,9 @@ func TestScanner_Scan(t *testing.T) {
 		{token.Func, "func"},
 		{token.If, "if"},
 		{token.Return, "return"},
+		{token.Select, "select"},
+		{token.Case, "case"},
+		{token.Default, "default"},
 	}
 
 	// combine
@@ -190,6 +194,16 @@ func TestScanner_Scan(t *testing.T) {
 	scanExpect(t, strings.Join(lines, "\n"), scanner.DontInsertSemis, expectedSkipComments...)
 }
 
+func TestScanner_ScanKeywordSelector(t *testing.T) {
+	// a keyword after a period is scanned as an identifier
+	scanExpect(t, "a.default\nselect", 0,
+		scanResult{Token: token.Ident, Literal: "a", Line: 1, Column: 1},
+		scanResult{Token: token.Period, Literal: "", Line: 1, Column: 2},
+		scanResult{Token: token.Ident, Literal: "default", Line: 1, Column: 3},
+		scanResult{Token: token.Semicolon, Literal: "\n", Line: 1, Column: 10},
+		scanResult{Token: token.Select, Literal: "select", Line: 2, Column: 1})
+}
+
 func TestStripCR(t *testing.T) {
 	for _, tc := range []struct {
 		input  string
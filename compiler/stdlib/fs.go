@@ -0,0 +1,108 @@
+package stdlib
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/d5/tengo/objects"
+)
+
+// FSModule is "fs" module that reads and writes files. It's not included
+// in Modules because it allows the scripts to access the file system:
+// it should be explicitly added (e.g. Script.EnableFileIO).
+var FSModule = &objects.ImmutableMap{Value: map[string]objects.Object{
+	"read_file":  &objects.UserFunction{Value: fsReadFile},  // read_file(path) => bytes/error
+	"write_file": &objects.UserFunction{Value: fsWriteFile}, // write_file(path, data) => true/error
+	"exists":     &objects.UserFunction{Value: fsExists},    // exists(path) => bool/error
+	"remove":     &objects.UserFunction{Value: fsRemove},    // remove(path) => true/error
+}}
+
+func fsReadFile(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	path, ok := objects.ToString(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		ret = wrapError(err)
+		err = nil
+		return
+	}
+
+	ret = &objects.Bytes{Value: data}
+
+	return
+}
+
+func fsWriteFile(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 2 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	path, ok := objects.ToString(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	data, ok := objects.ToByteSlice(args[1])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	ret = wrapError(ioutil.WriteFile(path, data, 0644))
+
+	return
+}
+
+func fsExists(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	path, ok := objects.ToString(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	_, err = os.Stat(path)
+	switch {
+	case err == nil:
+		ret = objects.TrueValue
+	case os.IsNotExist(err):
+		ret = objects.FalseValue
+	default:
+		ret = wrapError(err)
+	}
+	err = nil
+
+	return
+}
+
+func fsRemove(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	path, ok := objects.ToString(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	ret = wrapError(os.Remove(path))
+
+	return
+}
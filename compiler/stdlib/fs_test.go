@@ -0,0 +1,44 @@
+package stdlib_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/d5/tengo/assert"
+	"github.com/d5/tengo/compiler/stdlib"
+	"github.com/d5/tengo/objects"
+)
+
+func TestFS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tengo-fs")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	fs := callres{t: t, o: stdlib.FSModule}
+	file := filepath.Join(dir, "test.txt")
+
+	fs.call("exists", file).expect(false)
+	fs.call("write_file", file, []byte("hello")).expect(true)
+	fs.call("exists", file).expect(true)
+	fs.call("read_file", file).expect([]byte("hello"))
+
+	// overwrite with a string
+	fs.call("write_file", file, "world").expect(true)
+	fs.call("read_file", file).expect([]byte("world"))
+
+	fs.call("remove", file).expect(true)
+	fs.call("exists", file).expect(false)
+
+	// missing file
+	assert.IsType(t, &objects.Error{}, fs.call("read_file", file).o)
+	assert.IsType(t, &objects.Error{}, fs.call("remove", file).o)
+	assert.IsType(t, &objects.Error{}, fs.call("write_file", filepath.Join(dir, "missing", "a.txt"), "a").o)
+
+	fs.call("read_file").expectError()
+	fs.call("write_file", file).expectError()
+	fs.call("write_file", file, objects.UndefinedValue).expectError()
+}
@@ -395,6 +395,28 @@ func FuncASRS(fn func(string) string) *objects.UserFunction {
 	}
 }
 
+// FuncASRB transform a function of 'func(string) bool' signature into a user function object.
+func FuncASRB(fn func(string) bool) *objects.UserFunction {
+	return &objects.UserFunction{
+		Value: func(args ...objects.Object) (objects.Object, error) {
+			if len(args) != 1 {
+				return nil, objects.ErrWrongNumArguments
+			}
+
+			s1, ok := objects.ToString(args[0])
+			if !ok {
+				return nil, objects.ErrInvalidTypeConversion
+			}
+
+			if fn(s1) {
+				return objects.TrueValue, nil
+			}
+
+			return objects.FalseValue, nil
+		},
+	}
+}
+
 // FuncASRSs transform a function of 'func(string) []string' signature into a user function object.
 func FuncASRSs(fn func(string) []string) *objects.UserFunction {
 	return &objects.UserFunction{
@@ -117,6 +117,18 @@ func TestFuncASRS(t *testing.T) {
 	assert.Equal(t, objects.ErrWrongNumArguments, err)
 }
 
+func TestFuncASRB(t *testing.T) {
+	uf := stdlib.FuncASRB(func(a string) bool { return a == "foo" })
+	ret, err := uf.Call(&objects.String{Value: "foo"})
+	assert.NoError(t, err)
+	assert.Equal(t, objects.TrueValue, ret)
+	ret, err = uf.Call(&objects.String{Value: "bar"})
+	assert.NoError(t, err)
+	assert.Equal(t, objects.FalseValue, ret)
+	ret, err = uf.Call()
+	assert.Equal(t, objects.ErrWrongNumArguments, err)
+}
+
 func TestFuncASRSs(t *testing.T) {
 	uf := stdlib.FuncASRSs(func(a string) []string { return []string{a} })
 	ret, err := uf.Call(&objects.String{Value: "foo"})
@@ -0,0 +1,48 @@
+package stdlib
+
+import (
+	"html"
+	"strings"
+
+	"github.com/d5/tengo/objects"
+)
+
+var htmlModule = map[string]objects.Object{
+	"escape":      htmlFunc(html.EscapeString),   // escape(s) => string
+	"unescape":    htmlFunc(html.UnescapeString), // unescape(s) => string
+	"attr_escape": htmlFunc(htmlAttrEscape),      // attr_escape(s) => string
+}
+
+// htmlAttrReplacer escapes the characters that can end an unquoted
+// attribute value in addition to the ones escaped by html.EscapeString.
+var htmlAttrReplacer = strings.NewReplacer(
+	"`", "&#96;",
+	"=", "&#61;",
+	" ", "&#32;",
+	"\t", "&#9;",
+	"\n", "&#10;",
+	"\r", "&#13;",
+	"\f", "&#12;",
+)
+
+func htmlAttrEscape(s string) string {
+	return htmlAttrReplacer.Replace(html.EscapeString(s))
+}
+
+// htmlFunc is like FuncASRS, but it does not convert non-string arguments.
+func htmlFunc(fn func(string) string) *objects.UserFunction {
+	return &objects.UserFunction{
+		Value: func(args ...objects.Object) (objects.Object, error) {
+			if len(args) != 1 {
+				return nil, objects.ErrWrongNumArguments
+			}
+
+			s, ok := args[0].(*objects.String)
+			if !ok {
+				return nil, objects.ErrInvalidTypeConversion
+			}
+
+			return &objects.String{Value: fn(s.Value)}, nil
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package stdlib_test
+
+import (
+	"testing"
+)
+
+func TestHTML(t *testing.T) {
+	module(t, "html").call("escape", `<a href="x">Tom & Jerry's</a>`).expect("&lt;a href=&#34;x&#34;&gt;Tom &amp; Jerry&#39;s&lt;/a&gt;")
+	module(t, "html").call("escape", "plain text").expect("plain text")
+	module(t, "html").call("escape", "").expect("")
+
+	module(t, "html").call("unescape", "&lt;b&gt; &amp;amp; &quot;q&quot; &#39;s&#39; &eacute;").expect(`<b> &amp; "q" 's' é`)
+
+	module(t, "html").call("attr_escape", `a="b" c`).expect("a&#61;&#34;b&#34;&#32;c")
+	module(t, "html").call("attr_escape", "`<x>`\n").expect("&#96;&lt;x&gt;&#96;&#10;")
+
+	// round-trip
+	for _, s := range []string{`<script>alert("x & y")</script>`, "'single' `back` = tab\t", "日本語 & more"} {
+		escaped := module(t, "html").call("escape", s).o
+		module(t, "html").call("unescape", escaped).expect(s, "input: %q", s)
+		escaped = module(t, "html").call("attr_escape", s).o
+		module(t, "html").call("unescape", escaped).expect(s, "input: %q", s)
+	}
+
+	module(t, "html").call("escape", 1).expectError()
+	module(t, "html").call("unescape", []byte("a")).expectError()
+	module(t, "html").call("attr_escape").expectError()
+}
@@ -0,0 +1,205 @@
+package stdlib
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/objects"
+)
+
+// DefaultHTTPTimeout is the default timeout of the requests
+// made by "http" module.
+const DefaultHTTPTimeout = 30 * time.Second
+
+// DefaultHTTPMaxBodySize is the default maximum size of the response
+// bodies read by "http" module.
+const DefaultHTTPMaxBodySize = 10 * 1024 * 1024
+
+// HTTPModule creates "http" module with the given request timeout and
+// maximum response body size. It's not included in Modules because it
+// allows the scripts to access the network: it should be explicitly added
+// (e.g. Script.EnableHTTP).
+func HTTPModule(timeout time.Duration, maxBodySize int64) *objects.ImmutableMap {
+	c := &httpClient{timeout: timeout, maxBodySize: maxBodySize}
+
+	return &objects.ImmutableMap{Value: map[string]objects.Object{
+		"get":     &objects.InteropFunction{Value: c.get},     // get(url) => {status:, headers:, body:}/error
+		"post":    &objects.InteropFunction{Value: c.post},    // post(url, body, content_type) => {status:, headers:, body:}/error
+		"request": &objects.InteropFunction{Value: c.request}, // request(method, url, headers, body) => {status:, headers:, body:}/error
+	}}
+}
+
+type httpClient struct {
+	timeout     time.Duration
+	maxBodySize int64
+}
+
+func (c *httpClient) get(rt objects.Interop, args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	url, ok := objects.ToString(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	return c.do(rt, "GET", url, nil, nil)
+}
+
+func (c *httpClient) post(rt objects.Interop, args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 3 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	url, ok := objects.ToString(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	body, ok := httpBody(args[1])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	contentType, ok := objects.ToString(args[2])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	return c.do(rt, "POST", url, map[string]string{"Content-Type": contentType}, body)
+}
+
+func (c *httpClient) request(rt objects.Interop, args ...objects.Object) (ret objects.Object, err error) {
+	numArgs := len(args)
+	if numArgs < 2 || numArgs > 4 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	method, ok := objects.ToString(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	url, ok := objects.ToString(args[1])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	headers := make(map[string]string)
+	if numArgs > 2 {
+		var m map[string]objects.Object
+		switch arg := args[2].(type) {
+		case *objects.Map:
+			m = arg.Value
+		case *objects.ImmutableMap:
+			m = arg.Value
+		case *objects.Undefined:
+		default:
+			err = objects.ErrInvalidTypeConversion
+			return
+		}
+
+		for k, v := range m {
+			if headers[k], ok = objects.ToString(v); !ok {
+				err = objects.ErrInvalidTypeConversion
+				return
+			}
+		}
+	}
+
+	var body []byte
+	if numArgs > 3 {
+		if body, ok = httpBody(args[3]); !ok {
+			err = objects.ErrInvalidTypeConversion
+			return
+		}
+	}
+
+	return c.do(rt, strings.ToUpper(method), url, headers, body)
+}
+
+// do sends the request and returns the response as a map. The request is
+// canceled if the request times out or the execution is aborted.
+func (c *httpClient) do(rt objects.Interop, method, url string, headers map[string]string, body []byte) (objects.Object, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-rt.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, url, bodyReader)
+	if err != nil {
+		return wrapError(err), nil
+	}
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return wrapError(err), nil
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	// read one more byte to tell if the body is too large
+	resBody, err := ioutil.ReadAll(io.LimitReader(res.Body, c.maxBodySize+1))
+	if err != nil {
+		return wrapError(err), nil
+	}
+	if int64(len(resBody)) > c.maxBodySize {
+		return wrapError(fmt.Errorf("response body exceeds %d bytes", c.maxBodySize)), nil
+	}
+
+	resHeaders := make(map[string]objects.Object)
+	for k, vs := range res.Header {
+		resHeaders[k] = &objects.String{Value: strings.Join(vs, ", ")}
+	}
+
+	return &objects.ImmutableMap{Value: map[string]objects.Object{
+		"status":  &objects.Int{Value: int64(res.StatusCode)},
+		"headers": &objects.ImmutableMap{Value: resHeaders},
+		"body":    &objects.Bytes{Value: resBody},
+	}}, nil
+}
+
+// httpBody returns the request body from a string or bytes.
+// Undefined means no body.
+func httpBody(o objects.Object) ([]byte, bool) {
+	switch o := o.(type) {
+	case *objects.Undefined:
+		return nil, true
+	case *objects.Bytes:
+		return o.Value, true
+	case *objects.String:
+		return []byte(o.Value), true
+	}
+
+	return nil, false
+}
@@ -1,7 +1,10 @@
 package stdlib
 
 import (
+	"errors"
+	"fmt"
 	"math"
+	"math/big"
 
 	"github.com/d5/tengo/objects"
 )
@@ -28,6 +31,7 @@ var mathModule = map[string]objects.Object{
 	"atanh":     FuncAFRF(math.Atanh),
 	"cbrt":      FuncAFRF(math.Cbrt),
 	"ceil":      FuncAFRF(math.Ceil),
+	"comb":      &objects.UserFunction{Value: mathComb},
 	"copysign":  FuncAFFRF(math.Copysign),
 	"cos":       FuncAFRF(math.Cos),
 	"cosh":      FuncAFRF(math.Cosh),
@@ -37,8 +41,10 @@ var mathModule = map[string]objects.Object{
 	"exp":       FuncAFRF(math.Exp),
 	"exp2":      FuncAFRF(math.Exp2),
 	"expm1":     FuncAFRF(math.Expm1),
+	"factorial": &objects.UserFunction{Value: mathFactorial},
 	"floor":     FuncAFRF(math.Floor),
 	"gamma":     FuncAFRF(math.Gamma),
+	"gcd":       &objects.UserFunction{Value: mathGCD},
 	"hypot":     FuncAFFRF(math.Hypot),
 	"ilogb":     FuncAFRI(math.Ilogb),
 	"inf":       FuncAIRF(math.Inf),
@@ -47,6 +53,7 @@ var mathModule = map[string]objects.Object{
 	"j0":        FuncAFRF(math.J0),
 	"j1":        FuncAFRF(math.J1),
 	"jn":        FuncAIFRF(math.Jn),
+	"lcm":       &objects.UserFunction{Value: mathLCM},
 	"ldexp":     FuncAFIRF(math.Ldexp),
 	"log":       FuncAFRF(math.Log),
 	"log10":     FuncAFRF(math.Log10),
@@ -58,6 +65,7 @@ var mathModule = map[string]objects.Object{
 	"mod":       FuncAFFRF(math.Mod),
 	"nan":       FuncARF(math.NaN),
 	"nextafter": FuncAFFRF(math.Nextafter),
+	"perm":      &objects.UserFunction{Value: mathPerm},
 	"pow":       FuncAFFRF(math.Pow),
 	"pow10":     FuncAIRF(math.Pow10),
 	"remainder": FuncAFFRF(math.Remainder),
@@ -72,3 +80,173 @@ var mathModule = map[string]objects.Object{
 	"y1":        FuncAFRF(math.Y1),
 	"yn":        FuncAIFRF(math.Yn),
 }
+
+var errIntegerOverflow = errors.New("integer overflow")
+
+// mathIntArgs returns the values of the int arguments.
+func mathIntArgs(args []objects.Object, n int) ([]int64, error) {
+	if len(args) != n {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	values := make([]int64, n)
+	for i, arg := range args {
+		v, ok := arg.(*objects.Int)
+		if !ok {
+			return nil, objects.ErrInvalidTypeConversion
+		}
+		values[i] = v.Value
+	}
+
+	return values, nil
+}
+
+// gcd returns the greatest common divisor of |a| and |b|.
+func gcd(a, b int64) uint64 {
+	x, y := absUint64(a), absUint64(b)
+	for y != 0 {
+		x, y = y, x%y
+	}
+
+	return x
+}
+
+func absUint64(v int64) uint64 {
+	if v < 0 {
+		return uint64(-v) // -math.MinInt64 wraps around to 1<<63
+	}
+
+	return uint64(v)
+}
+
+func mathGCD(args ...objects.Object) (ret objects.Object, err error) {
+	values, err := mathIntArgs(args, 2)
+	if err != nil {
+		return
+	}
+
+	g := gcd(values[0], values[1])
+	if g > math.MaxInt64 {
+		ret = wrapError(errIntegerOverflow)
+		return
+	}
+
+	ret = &objects.Int{Value: int64(g)}
+
+	return
+}
+
+func mathLCM(args ...objects.Object) (ret objects.Object, err error) {
+	values, err := mathIntArgs(args, 2)
+	if err != nil {
+		return
+	}
+
+	if values[0] == 0 || values[1] == 0 {
+		ret = &objects.Int{Value: 0}
+		return
+	}
+
+	a := absUint64(values[0]) / gcd(values[0], values[1])
+	b := absUint64(values[1])
+	if a > math.MaxInt64/b {
+		ret = wrapError(errIntegerOverflow)
+		return
+	}
+
+	ret = &objects.Int{Value: int64(a * b)}
+
+	return
+}
+
+// mathIntResult returns an int object if v fits in int64
+// or an overflow error object otherwise.
+func mathIntResult(v *big.Int) objects.Object {
+	if !v.IsInt64() {
+		return wrapError(errIntegerOverflow)
+	}
+
+	return &objects.Int{Value: v.Int64()}
+}
+
+// mathNK returns the arguments of comb and perm.
+func mathNK(args []objects.Object) (n, k int64, errObj objects.Object, err error) {
+	values, err := mathIntArgs(args, 2)
+	if err != nil {
+		return
+	}
+
+	n, k = values[0], values[1]
+	if n < 0 || k < 0 || k > n {
+		errObj = wrapError(fmt.Errorf("invalid arguments: n=%d, k=%d", n, k))
+	}
+
+	return
+}
+
+func mathFactorial(args ...objects.Object) (ret objects.Object, err error) {
+	values, err := mathIntArgs(args, 1)
+	if err != nil {
+		return
+	}
+
+	n := values[0]
+	switch {
+	case n < 0:
+		ret = wrapError(fmt.Errorf("invalid argument: n=%d", n))
+	case n > 20: // 21! overflows int64
+		ret = wrapError(errIntegerOverflow)
+	default:
+		ret = mathIntResult(new(big.Int).MulRange(1, n))
+	}
+
+	return
+}
+
+func mathComb(args ...objects.Object) (ret objects.Object, err error) {
+	n, k, ret, err := mathNK(args)
+	if ret != nil || err != nil {
+		return
+	}
+
+	if n-k < k {
+		k = n - k
+	}
+
+	// C(68, 34) overflows int64, so does C(n, k) for all n >= 2k >= 68
+	if k >= 34 {
+		ret = wrapError(errIntegerOverflow)
+		return
+	}
+
+	// c = c * (n - i) / (i + 1) is always an integer
+	c := big.NewInt(1)
+	for i := int64(0); i < k; i++ {
+		c.Mul(c, big.NewInt(n-i))
+		c.Quo(c, big.NewInt(i+1))
+	}
+
+	ret = mathIntResult(c)
+
+	return
+}
+
+func mathPerm(args ...objects.Object) (ret objects.Object, err error) {
+	n, k, ret, err := mathNK(args)
+	if ret != nil || err != nil {
+		return
+	}
+
+	p := big.NewInt(1)
+	for i := int64(0); i < k; i++ {
+		p.Mul(p, big.NewInt(n-i))
+		if !p.IsInt64() {
+			ret = wrapError(errIntegerOverflow)
+			return
+		}
+	}
+
+	ret = mathIntResult(p)
+
+	return
+}
@@ -0,0 +1,71 @@
+package stdlib_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestMathGCDLCM(t *testing.T) {
+	overflow := &objects.Error{Value: &objects.String{Value: "integer overflow"}}
+
+	module(t, "math").call("gcd", 12, 18).expect(6)
+	module(t, "math").call("gcd", 7, 13).expect(1)
+	module(t, "math").call("gcd", 0, 5).expect(5)
+	module(t, "math").call("gcd", 5, 0).expect(5)
+	module(t, "math").call("gcd", 0, 0).expect(0)
+	module(t, "math").call("gcd", -12, 18).expect(6)
+	module(t, "math").call("gcd", -12, -18).expect(6)
+	module(t, "math").call("gcd", int64(-9223372036854775808), 6).expect(2)
+	module(t, "math").call("gcd", int64(-9223372036854775808), 0).expect(overflow)
+
+	module(t, "math").call("lcm", 4, 6).expect(12)
+	module(t, "math").call("lcm", 7, 13).expect(91)
+	module(t, "math").call("lcm", 0, 5).expect(0)
+	module(t, "math").call("lcm", 0, 0).expect(0)
+	module(t, "math").call("lcm", -4, 6).expect(12)
+	module(t, "math").call("lcm", -4, -6).expect(12)
+	module(t, "math").call("lcm", 4611686018427387904, 2).expect(4611686018427387904)
+	module(t, "math").call("lcm", 4611686018427387904, 3).expect(overflow)
+	module(t, "math").call("lcm", int64(-9223372036854775808), 1).expect(overflow)
+
+	module(t, "math").call("gcd", 1).expectError()
+	module(t, "math").call("gcd", 1.5, 2).expectError()
+	module(t, "math").call("lcm", "4", 6).expectError()
+}
+
+func TestMathCombinatorics(t *testing.T) {
+	overflow := &objects.Error{Value: &objects.String{Value: "integer overflow"}}
+
+	module(t, "math").call("factorial", 0).expect(1)
+	module(t, "math").call("factorial", 1).expect(1)
+	module(t, "math").call("factorial", 5).expect(120)
+	module(t, "math").call("factorial", 20).expect(2432902008176640000)
+	module(t, "math").call("factorial", 21).expect(overflow)
+	module(t, "math").call("factorial", -1).expect(&objects.Error{Value: &objects.String{Value: "invalid argument: n=-1"}})
+
+	module(t, "math").call("comb", 5, 2).expect(10)
+	module(t, "math").call("comb", 5, 0).expect(1)
+	module(t, "math").call("comb", 5, 5).expect(1)
+	module(t, "math").call("comb", 52, 5).expect(2598960)
+	module(t, "math").call("comb", 66, 33).expect(7219428434016265740)
+	module(t, "math").call("comb", 68, 34).expect(overflow)
+	module(t, "math").call("comb", 1000000000, 999999999).expect(1000000000)
+	module(t, "math").call("comb", 1000000000, 999999990).expect(overflow)
+
+	module(t, "math").call("perm", 5, 2).expect(20)
+	module(t, "math").call("perm", 5, 0).expect(1)
+	module(t, "math").call("perm", 5, 5).expect(120)
+	module(t, "math").call("perm", 1000000000, 2).expect(999999999000000000)
+	module(t, "math").call("perm", 1000000000, 1000000000).expect(overflow)
+
+	invalid := &objects.Error{Value: &objects.String{Value: "invalid arguments: n=2, k=3"}}
+	module(t, "math").call("comb", 2, 3).expect(invalid)
+	module(t, "math").call("perm", 2, 3).expect(invalid)
+	module(t, "math").call("comb", -1, 0).expect(&objects.Error{Value: &objects.String{Value: "invalid arguments: n=-1, k=0"}})
+	module(t, "math").call("perm", 3, -1).expect(&objects.Error{Value: &objects.String{Value: "invalid arguments: n=3, k=-1"}})
+
+	module(t, "math").call("factorial").expectError()
+	module(t, "math").call("comb", 5).expectError()
+	module(t, "math").call("perm", 5.0, 2).expectError()
+}
@@ -0,0 +1,32 @@
+package stdlib
+
+import (
+	"path"
+
+	"github.com/d5/tengo/objects"
+)
+
+var pathModule = map[string]objects.Object{
+	"join":   &objects.UserFunction{Value: pathJoin}, // join(parts...) => string
+	"dir":    FuncASRS(path.Dir),                     // dir(p) => string
+	"base":   FuncASRS(path.Base),                    // base(p) => string
+	"ext":    FuncASRS(path.Ext),                     // ext(p) => string
+	"clean":  FuncASRS(path.Clean),                   // clean(p) => string
+	"is_abs": FuncASRB(path.IsAbs),                   // is_abs(p) => bool
+}
+
+func pathJoin(args ...objects.Object) (ret objects.Object, err error) {
+	var parts []string
+	for _, arg := range args {
+		s, ok := objects.ToString(arg)
+		if !ok {
+			err = objects.ErrInvalidTypeConversion
+			return
+		}
+		parts = append(parts, s)
+	}
+
+	ret = &objects.String{Value: path.Join(parts...)}
+
+	return
+}
@@ -0,0 +1,39 @@
+package stdlib_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestPath(t *testing.T) {
+	module(t, "path").call("join", "a", "b", "c").expect("a/b/c")
+	module(t, "path").call("join", "a//", "/b/", "c/").expect("a/b/c")
+	module(t, "path").call("join", "/a", "", "b").expect("/a/b")
+	module(t, "path").call("join", "a", "../b").expect("b")
+	module(t, "path").call("join").expect("")
+
+	module(t, "path").call("dir", "/a/b/c.txt").expect("/a/b")
+	module(t, "path").call("dir", "c.txt").expect(".")
+	module(t, "path").call("base", "/a/b/c.txt").expect("c.txt")
+	module(t, "path").call("base", "/a/b/").expect("b")
+	module(t, "path").call("base", "").expect(".")
+
+	module(t, "path").call("ext", "c.txt").expect(".txt")
+	module(t, "path").call("ext", "/a/b.tar.gz").expect(".gz")
+	module(t, "path").call("ext", "/a.b/c").expect("")
+	module(t, "path").call("ext", ".bashrc").expect(".bashrc")
+
+	module(t, "path").call("clean", "a/b/../c").expect("a/c")
+	module(t, "path").call("clean", "/../a/./b//c/..").expect("/a/b")
+	module(t, "path").call("clean", "../../a").expect("../../a")
+	module(t, "path").call("clean", "a/..").expect(".")
+	module(t, "path").call("clean", "").expect(".")
+
+	module(t, "path").call("is_abs", "/a").expect(true)
+	module(t, "path").call("is_abs", "a/b").expect(false)
+
+	module(t, "path").call("dir").expectError()
+	module(t, "path").call("join", "a", []byte("b")).expect("a/b")
+	module(t, "path").call("join", "a", objects.UndefinedValue).expectError()
+}
@@ -0,0 +1,154 @@
+package stdlib
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/d5/tengo/objects"
+)
+
+// RandModule creates "rand" module with its own source, seeded with the
+// current time. Script creates a new module for each compilation, so the
+// scripts don't share the source.
+func RandModule() *objects.ImmutableMap {
+	r := &randSource{r: rand.New(rand.NewSource(time.Now().UnixNano()))}
+
+	return &objects.ImmutableMap{Value: map[string]objects.Object{
+		"seed":    &objects.UserFunction{Value: r.seed},    // seed(s)
+		"int":     &objects.UserFunction{Value: r.int},     // int() => int
+		"intn":    &objects.UserFunction{Value: r.intn},    // intn(n) => int
+		"float":   &objects.UserFunction{Value: r.float},   // float() => float
+		"shuffle": &objects.UserFunction{Value: r.shuffle}, // shuffle(arr) => array
+		"sample":  &objects.UserFunction{Value: r.sample},  // sample(arr, k) => array
+	}}
+}
+
+// randSource is the source of a rand module. The module can be used by
+// multiple goroutines (e.g. go() tasks), and rand.Rand is not safe for
+// concurrent use.
+type randSource struct {
+	sync.Mutex
+	r *rand.Rand
+}
+
+func (r *randSource) seed(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	s, ok := args[0].(*objects.Int)
+	if !ok {
+		return nil, objects.ErrInvalidTypeConversion
+	}
+
+	r.Lock()
+	r.r.Seed(s.Value)
+	r.Unlock()
+
+	return objects.UndefinedValue, nil
+}
+
+func (r *randSource) int(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 0 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	return &objects.Int{Value: r.r.Int63()}, nil
+}
+
+func (r *randSource) intn(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	n, ok := args[0].(*objects.Int)
+	if !ok {
+		return nil, objects.ErrInvalidTypeConversion
+	}
+
+	if n.Value <= 0 {
+		return wrapError(fmt.Errorf("invalid argument: n=%d", n.Value)), nil
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	return &objects.Int{Value: r.r.Int63n(n.Value)}, nil
+}
+
+func (r *randSource) float(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 0 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	return &objects.Float{Value: r.r.Float64()}, nil
+}
+
+// randElements returns a copy of the elements of the array argument.
+func randElements(arg objects.Object) ([]objects.Object, error) {
+	switch arg := arg.(type) {
+	case *objects.Array:
+		return append([]objects.Object{}, arg.Value...), nil
+	case *objects.ImmutableArray:
+		return append([]objects.Object{}, arg.Value...), nil
+	}
+
+	return nil, objects.ErrInvalidTypeConversion
+}
+
+func (r *randSource) shuffle(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	elements, err := randElements(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Lock()
+	r.r.Shuffle(len(elements), func(i, j int) {
+		elements[i], elements[j] = elements[j], elements[i]
+	})
+	r.Unlock()
+
+	return &objects.Array{Value: elements}, nil
+}
+
+func (r *randSource) sample(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	elements, err := randElements(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	k, ok := args[1].(*objects.Int)
+	if !ok {
+		return nil, objects.ErrInvalidTypeConversion
+	}
+
+	if k.Value < 0 || k.Value > int64(len(elements)) {
+		return wrapError(fmt.Errorf("invalid sample size: %d", k.Value)), nil
+	}
+
+	// partial Fisher-Yates shuffle of the first k elements
+	r.Lock()
+	for i := 0; i < int(k.Value); i++ {
+		j := i + r.r.Intn(len(elements)-i)
+		elements[i], elements[j] = elements[j], elements[i]
+	}
+	r.Unlock()
+
+	return &objects.Array{Value: elements[:k.Value]}, nil
+}
@@ -0,0 +1,100 @@
+package stdlib_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/assert"
+	"github.com/d5/tengo/objects"
+)
+
+func TestRand(t *testing.T) {
+	module(t, "rand").call("seed", 42).expect(objects.UndefinedValue)
+	module(t, "rand").call("seed").expectError()
+	module(t, "rand").call("seed", "42").expectError()
+
+	module(t, "rand").call("intn", 0).expect(&objects.Error{Value: &objects.String{Value: "invalid argument: n=0"}})
+	module(t, "rand").call("intn", 1).expect(0)
+	module(t, "rand").call("intn").expectError()
+	module(t, "rand").call("int", 1).expectError()
+	module(t, "rand").call("float", 1).expectError()
+
+	res := module(t, "rand").call("float")
+	f, ok := res.o.(*objects.Float)
+	assert.True(t, ok)
+	assert.True(t, f.Value >= 0 && f.Value < 1)
+}
+
+func TestRandShuffle(t *testing.T) {
+	// fixed seed yields a deterministic permutation
+	module(t, "rand").call("seed", 42)
+	first := module(t, "rand").call("shuffle", ARR{1, 2, 3, 4, 5, 6, 7, 8}).o
+	module(t, "rand").call("seed", 42)
+	module(t, "rand").call("shuffle", ARR{1, 2, 3, 4, 5, 6, 7, 8}).expect(first)
+
+	// all elements are preserved
+	arr, ok := first.(*objects.Array)
+	assert.True(t, ok)
+	assert.Equal(t, 8, len(arr.Value))
+	seen := make(map[int64]bool)
+	for _, e := range arr.Value {
+		seen[e.(*objects.Int).Value] = true
+	}
+	for i := int64(1); i <= 8; i++ {
+		assert.True(t, seen[i])
+	}
+
+	// the original is untouched
+	src := &objects.Array{Value: []objects.Object{&objects.Int{Value: 1}, &objects.Int{Value: 2}, &objects.Int{Value: 3}}}
+	module(t, "rand").call("shuffle", src)
+	assert.Equal(t, int64(1), src.Value[0].(*objects.Int).Value)
+	assert.Equal(t, int64(2), src.Value[1].(*objects.Int).Value)
+	assert.Equal(t, int64(3), src.Value[2].(*objects.Int).Value)
+
+	module(t, "rand").call("shuffle", IARR{1}).expect(ARR{1})
+	module(t, "rand").call("shuffle", ARR{}).expect(ARR{})
+	module(t, "rand").call("shuffle").expectError()
+	module(t, "rand").call("shuffle", 1).expectError()
+}
+
+func TestRandSample(t *testing.T) {
+	src := ARR{1, 2, 3, 4, 5, 6, 7, 8}
+
+	// k less than the length: distinct elements of the array
+	module(t, "rand").call("seed", 7)
+	first := module(t, "rand").call("sample", src, 3).o
+	arr, ok := first.(*objects.Array)
+	assert.True(t, ok)
+	assert.Equal(t, 3, len(arr.Value))
+	seen := make(map[int64]bool)
+	for _, e := range arr.Value {
+		v := e.(*objects.Int).Value
+		assert.True(t, v >= 1 && v <= 8)
+		assert.False(t, seen[v])
+		seen[v] = true
+	}
+
+	module(t, "rand").call("seed", 7)
+	module(t, "rand").call("sample", src, 3).expect(first)
+
+	// k equal to the length: a permutation
+	res := module(t, "rand").call("sample", IARR{1, 2, 3}, 3).o
+	arr, ok = res.(*objects.Array)
+	assert.True(t, ok)
+	assert.Equal(t, 3, len(arr.Value))
+	sum := int64(0)
+	for _, e := range arr.Value {
+		sum += e.(*objects.Int).Value
+	}
+	assert.Equal(t, int64(6), sum)
+
+	module(t, "rand").call("sample", src, 0).expect(ARR{})
+	module(t, "rand").call("sample", ARR{}, 0).expect(ARR{})
+
+	// k too large
+	module(t, "rand").call("sample", ARR{1, 2}, 3).expect(&objects.Error{Value: &objects.String{Value: "invalid sample size: 3"}})
+	module(t, "rand").call("sample", ARR{1, 2}, -1).expect(&objects.Error{Value: &objects.String{Value: "invalid sample size: -1"}})
+
+	module(t, "rand").call("sample", src).expectError()
+	module(t, "rand").call("sample", 1, 1).expectError()
+	module(t, "rand").call("sample", src, "1").expectError()
+}
@@ -0,0 +1,186 @@
+package stdlib
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/d5/tengo/objects"
+)
+
+var (
+	errEmptyArray  = errors.New("empty array")
+	errIntOverflow = errors.New("integer overflow")
+)
+
+var statsModule = map[string]objects.Object{
+	"sum":      &objects.UserFunction{Value: statsSum}, // sum(arr) => int/float
+	"min":      statsFloatFunc(statsMin),               // min(arr) => float
+	"max":      statsFloatFunc(statsMax),               // max(arr) => float
+	"mean":     statsFloatFunc(statsMean),              // mean(arr) => float
+	"median":   statsFloatFunc(statsMedian),            // median(arr) => float
+	"variance": statsFloatFunc(statsVariance),          // variance(arr) => float
+	"stddev":   statsFloatFunc(statsStddev),            // stddev(arr) => float
+}
+
+// statsArgs returns the elements of the numeric array argument and
+// whether all of them are int values. It returns a nil slice
+// if the array is empty.
+func statsArgs(args []objects.Object) (elements []objects.Object, allInt bool, err error) {
+	if len(args) != 1 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	switch arg := args[0].(type) {
+	case *objects.Array:
+		elements = arg.Value
+	case *objects.ImmutableArray:
+		elements = arg.Value
+	default:
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	allInt = true
+	for _, e := range elements {
+		switch e.(type) {
+		case *objects.Int:
+		case *objects.Float:
+			allInt = false
+		default:
+			err = objects.ErrInvalidTypeConversion
+			return
+		}
+	}
+
+	return
+}
+
+func statsFloat(o objects.Object) float64 {
+	if i, ok := o.(*objects.Int); ok {
+		return float64(i.Value)
+	}
+
+	return o.(*objects.Float).Value
+}
+
+func statsFloats(elements []objects.Object) []float64 {
+	values := make([]float64, len(elements))
+	for i, e := range elements {
+		values[i] = statsFloat(e)
+	}
+
+	return values
+}
+
+func statsSum(args ...objects.Object) (ret objects.Object, err error) {
+	elements, allInt, err := statsArgs(args)
+	if err != nil {
+		return
+	}
+
+	if len(elements) == 0 {
+		ret = wrapError(errEmptyArray)
+		return
+	}
+
+	if allInt {
+		var sum int64
+		for _, e := range elements {
+			v := e.(*objects.Int).Value
+			if (v > 0 && sum > math.MaxInt64-v) || (v < 0 && sum < math.MinInt64-v) {
+				ret = wrapError(errIntOverflow)
+				return
+			}
+			sum += v
+		}
+		ret = &objects.Int{Value: sum}
+		return
+	}
+
+	var sum float64
+	for _, v := range statsFloats(elements) {
+		sum += v
+	}
+	ret = &objects.Float{Value: sum}
+
+	return
+}
+
+// statsFloatFunc wraps a function that computes a float value
+// from a non-empty slice of values.
+func statsFloatFunc(fn func(values []float64) float64) *objects.UserFunction {
+	return &objects.UserFunction{
+		Value: func(args ...objects.Object) (ret objects.Object, err error) {
+			elements, _, err := statsArgs(args)
+			if err != nil {
+				return
+			}
+
+			if len(elements) == 0 {
+				ret = wrapError(errEmptyArray)
+				return
+			}
+
+			ret = &objects.Float{Value: fn(statsFloats(elements))}
+
+			return
+		},
+	}
+}
+
+func statsMin(values []float64) float64 {
+	res := values[0]
+	for _, v := range values[1:] {
+		res = math.Min(res, v)
+	}
+
+	return res
+}
+
+func statsMax(values []float64) float64 {
+	res := values[0]
+	for _, v := range values[1:] {
+		res = math.Max(res, v)
+	}
+
+	return res
+}
+
+func statsMean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+
+	return sum / float64(len(values))
+}
+
+func statsMedian(values []float64) float64 {
+	sort.Float64s(values)
+
+	n := len(values)
+	if n%2 == 1 {
+		return values[n/2]
+	}
+
+	return (values[n/2-1] + values[n/2]) / 2
+}
+
+// statsVariance returns the population variance of the values.
+func statsVariance(values []float64) float64 {
+	mean := statsMean(values)
+
+	var sum float64
+	for _, v := range values {
+		sum += (v - mean) * (v - mean)
+	}
+
+	return sum / float64(len(values))
+}
+
+// statsStddev returns the population standard deviation of the values.
+func statsStddev(values []float64) float64 {
+	return math.Sqrt(statsVariance(values))
+}
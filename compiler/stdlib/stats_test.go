@@ -0,0 +1,52 @@
+package stdlib_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestStats(t *testing.T) {
+	data := ARR{2, 4, 4, 4, 5, 5, 7, 9}
+	floats := ARR{2.5, 1, 4.0, -0.5}
+	empty := &objects.Error{Value: &objects.String{Value: "empty array"}}
+
+	module(t, "stats").call("sum", data).expect(40)
+	module(t, "stats").call("sum", IARR{1, 2, 3}).expect(6)
+	module(t, "stats").call("sum", floats).expect(7.0)
+	module(t, "stats").call("sum", ARR{}).expect(empty)
+	overflow := &objects.Error{Value: &objects.String{Value: "integer overflow"}}
+	module(t, "stats").call("sum", ARR{int64(math.MaxInt64), 1}).expect(overflow)
+	module(t, "stats").call("sum", ARR{int64(math.MinInt64), -1}).expect(overflow)
+	module(t, "stats").call("sum", ARR{int64(math.MaxInt64), 1, -1}).expect(overflow)
+	module(t, "stats").call("sum", ARR{int64(math.MaxInt64), -1, 1}).expect(int64(math.MaxInt64))
+
+	module(t, "stats").call("min", data).expect(2.0)
+	module(t, "stats").call("min", floats).expect(-0.5)
+	module(t, "stats").call("min", ARR{}).expect(empty)
+	module(t, "stats").call("max", data).expect(9.0)
+	module(t, "stats").call("max", floats).expect(4.0)
+	module(t, "stats").call("max", ARR{}).expect(empty)
+
+	module(t, "stats").call("mean", data).expect(5.0)
+	module(t, "stats").call("mean", floats).expect(1.75)
+	module(t, "stats").call("mean", ARR{}).expect(empty)
+
+	module(t, "stats").call("median", data).expect(4.5)
+	module(t, "stats").call("median", ARR{3, 1, 2}).expect(2.0)
+	module(t, "stats").call("median", floats).expect(1.75)
+	module(t, "stats").call("median", ARR{}).expect(empty)
+
+	module(t, "stats").call("variance", data).expect(4.0)
+	module(t, "stats").call("variance", ARR{1}).expect(0.0)
+	module(t, "stats").call("variance", ARR{}).expect(empty)
+	module(t, "stats").call("stddev", data).expect(2.0)
+	module(t, "stats").call("stddev", ARR{}).expect(empty)
+
+	module(t, "stats").call("sum").expectError()
+	module(t, "stats").call("sum", data, data).expectError()
+	module(t, "stats").call("mean", 1).expectError()
+	module(t, "stats").call("median", ARR{1, "2"}).expectError()
+	module(t, "stats").call("max", MAP{"a": 1}).expectError()
+}
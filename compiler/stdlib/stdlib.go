@@ -4,8 +4,14 @@ import "github.com/d5/tengo/objects"
 
 // Modules contain the standard modules.
 var Modules = map[string]*objects.ImmutableMap{
-	"math":  {Value: mathModule},
-	"os":    {Value: osModule},
-	"text":  {Value: textModule},
-	"times": {Value: timesModule},
+	"math":     {Value: mathModule},
+	"os":       {Value: osModule},
+	"text":     {Value: textModule},
+	"times":    {Value: timesModule},
+	"url":      {Value: urlModule},
+	"template": {Value: templateModule},
+	"html":     {Value: htmlModule},
+	"path":     {Value: pathModule},
+	"stats":    {Value: statsModule},
+	"rand":     RandModule(),
 }
@@ -0,0 +1,176 @@
+package stdlib
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/d5/tengo/compiler/token"
+	"github.com/d5/tengo/objects"
+)
+
+var templateModule = map[string]objects.Object{
+	"parse":  &objects.UserFunction{Value: templateParse},  // parse(source) => template/error
+	"render": &objects.UserFunction{Value: templateRender}, // render(tmpl, data) => string/error
+}
+
+// Template represents a parsed text/template.
+type Template struct {
+	Value *template.Template
+}
+
+// TypeName returns the name of the type.
+func (o *Template) TypeName() string {
+	return "template"
+}
+
+func (o *Template) String() string {
+	return "<template>"
+}
+
+// BinaryOp returns another object that is the result of
+// a given binary operator and a right-hand side object.
+func (o *Template) BinaryOp(op token.Token, rhs objects.Object) (objects.Object, error) {
+	return nil, objects.ErrInvalidOperator
+}
+
+// Copy returns a copy of the type.
+func (o *Template) Copy() objects.Object {
+	return o
+}
+
+// IsFalsy returns true if the value of the type is falsy.
+func (o *Template) IsFalsy() bool {
+	return false
+}
+
+// Equals returns true if the value of the type
+// is equal to the value of another object.
+func (o *Template) Equals(x objects.Object) bool {
+	return o == x
+}
+
+// IndexGet returns the template method for the given name.
+func (o *Template) IndexGet(index objects.Object) (res objects.Object, err error) {
+	strIdx, ok := index.(*objects.String)
+	if !ok {
+		err = objects.ErrInvalidIndexType
+		return
+	}
+
+	switch strIdx.Value {
+	case "render": // render(data) => string/error
+		res = &objects.UserFunction{Value: func(args ...objects.Object) (objects.Object, error) {
+			if len(args) != 1 {
+				return nil, objects.ErrWrongNumArguments
+			}
+
+			return o.render(args[0]), nil
+		}}
+	default:
+		res = objects.UndefinedValue
+	}
+
+	return
+}
+
+func (o *Template) render(data objects.Object) objects.Object {
+	var buf bytes.Buffer
+	if err := o.Value.Execute(&buf, templateData(data)); err != nil {
+		return wrapError(err)
+	}
+
+	return &objects.String{Value: buf.String()}
+}
+
+func templateParse(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	s, ok := objects.ToString(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	// missing map keys are errors instead of "<no value>"
+	tmpl, err := template.New("").Option("missingkey=error").Parse(s)
+	if err != nil {
+		ret = wrapError(err)
+		err = nil
+		return
+	}
+
+	ret = &Template{Value: tmpl}
+
+	return
+}
+
+func templateRender(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 2 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	tmpl, ok := args[0].(*Template)
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	ret = tmpl.render(args[1])
+
+	return
+}
+
+// templateData converts the object to a value that can be used
+// as the dot context of the template.
+func templateData(o objects.Object) interface{} {
+	switch o := o.(type) {
+	case *objects.Int:
+		return o.Value
+	case *objects.Float:
+		return o.Value
+	case *objects.String:
+		return o.Value
+	case *objects.Bool:
+		return !o.IsFalsy()
+	case *objects.Char:
+		return string(o.Value)
+	case *objects.Bytes:
+		return string(o.Value)
+	case *objects.Time:
+		return o.Value
+	case *objects.Undefined:
+		return nil
+	case *objects.Array:
+		return templateArray(o.Value)
+	case *objects.ImmutableArray:
+		return templateArray(o.Value)
+	case *objects.Map:
+		return templateMap(o.Value)
+	case *objects.ImmutableMap:
+		return templateMap(o.Value)
+	}
+
+	return o
+}
+
+func templateArray(v []objects.Object) []interface{} {
+	res := make([]interface{}, len(v))
+	for i, e := range v {
+		res[i] = templateData(e)
+	}
+
+	return res
+}
+
+func templateMap(v map[string]objects.Object) map[string]interface{} {
+	res := make(map[string]interface{}, len(v))
+	for k, e := range v {
+		res[k] = templateData(e)
+	}
+
+	return res
+}
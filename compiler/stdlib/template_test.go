@@ -0,0 +1,35 @@
+package stdlib_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/assert"
+	"github.com/d5/tengo/compiler/stdlib"
+	"github.com/d5/tengo/objects"
+)
+
+func TestTemplate(t *testing.T) {
+	tmpl := module(t, "template").call("parse", `{{.name}}:{{range $i, $e := .items}} {{$i}}={{$e}}{{end}}`).o
+	assert.IsType(t, &stdlib.Template{}, tmpl)
+
+	module(t, "template").call("render", tmpl, MAP{"name": "list", "items": ARR{"a", 2, true}}).expect("list: 0=a 1=2 2=true")
+	module(t, "template").call("render", tmpl, IMAP{"name": "empty", "items": IARR{}}).expect("empty:")
+
+	// nested values
+	tmpl = module(t, "template").call("parse", `{{range .users}}{{.name}}({{.age}}) {{end}}`).o
+	module(t, "template").call("render", tmpl, MAP{"users": ARR{
+		MAP{"name": "foo", "age": 20},
+		MAP{"name": "bar", "age": 30},
+	}}).expect("foo(20) bar(30) ")
+
+	// missing key
+	tmpl = module(t, "template").call("parse", `Hello, {{.name}}!`).o
+	module(t, "template").call("render", tmpl, MAP{"name": "world"}).expect("Hello, world!")
+	assert.IsType(t, &objects.Error{}, module(t, "template").call("render", tmpl, MAP{}).o)
+
+	// parse error
+	assert.IsType(t, &objects.Error{}, module(t, "template").call("parse", `{{.name`).o)
+
+	module(t, "template").call("render", "foo", MAP{}).expectError()
+	module(t, "template").call("parse").expectError()
+}
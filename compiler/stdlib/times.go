@@ -22,6 +22,9 @@ var timesModule = map[string]objects.Object{
 	"format_stamp_milli":   &objects.String{Value: time.StampMilli},
 	"format_stamp_micro":   &objects.String{Value: time.StampMicro},
 	"format_stamp_nano":    &objects.String{Value: time.StampNano},
+	"format_date_only":     &objects.String{Value: "2006-01-02"},
+	"format_time_only":     &objects.String{Value: "15:04:05"},
+	"format_datetime":      &objects.String{Value: "2006-01-02 15:04:05"},
 	"nanosecond":           &objects.Int{Value: int64(time.Nanosecond)},
 	"microsecond":          &objects.Int{Value: int64(time.Microsecond)},
 	"millisecond":          &objects.Int{Value: int64(time.Millisecond)},
@@ -56,6 +59,8 @@ var timesModule = map[string]objects.Object{
 	"unix":                 &objects.UserFunction{Value: timesUnix},                // unix(sec, nsec) => time
 	"add":                  &objects.UserFunction{Value: timesAdd},                 // add(time, int) => time
 	"add_date":             &objects.UserFunction{Value: timesAddDate},             // add_date(time, years, months, days) => time
+	"truncate":             &objects.UserFunction{Value: timesTruncate},            // truncate(time, int) => time
+	"round":                &objects.UserFunction{Value: timesRound},               // round(time, int) => time
 	"sub":                  &objects.UserFunction{Value: timesSub},                 // sub(t time, u time) => int
 	"after":                &objects.UserFunction{Value: timesAfter},               // after(t time, u time) => bool
 	"before":               &objects.UserFunction{Value: timesBefore},              // before(t time, u time) => bool
@@ -63,6 +68,7 @@ var timesModule = map[string]objects.Object{
 	"time_month":           &objects.UserFunction{Value: timesTimeMonth},           // time_month(time) => int
 	"time_day":             &objects.UserFunction{Value: timesTimeDay},             // time_day(time) => int
 	"time_weekday":         &objects.UserFunction{Value: timesTimeWeekday},         // time_weekday(time) => int
+	"time_yearday":         &objects.UserFunction{Value: timesTimeYearday},         // time_yearday(time) => int
 	"time_hour":            &objects.UserFunction{Value: timesTimeHour},            // time_hour(time) => int
 	"time_minute":          &objects.UserFunction{Value: timesTimeMinute},          // time_minute(time) => int
 	"time_second":          &objects.UserFunction{Value: timesTimeSecond},          // time_second(time) => int
@@ -75,6 +81,7 @@ var timesModule = map[string]objects.Object{
 	"is_zero":              &objects.UserFunction{Value: timesIsZero},              // is_zero(time) => bool
 	"to_local":             &objects.UserFunction{Value: timesToLocal},             // to_local(time) => time
 	"to_utc":               &objects.UserFunction{Value: timesToUTC},               // to_utc(time) => time
+	"to_location":          &objects.UserFunction{Value: timesToLocation},          // to_location(time, name) => time/error
 }
 
 func timesSleep(args ...objects.Object) (ret objects.Object, err error) {
@@ -387,6 +394,52 @@ func timesAdd(args ...objects.Object) (ret objects.Object, err error) {
 	return
 }
 
+func timesTruncate(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 2 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	t1, ok := objects.ToTime(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	i2, ok := objects.ToInt64(args[1])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	ret = &objects.Time{Value: t1.Truncate(time.Duration(i2))}
+
+	return
+}
+
+func timesRound(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 2 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	t1, ok := objects.ToTime(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	i2, ok := objects.ToInt64(args[1])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	ret = &objects.Time{Value: t1.Round(time.Duration(i2))}
+
+	return
+}
+
 func timesSub(args ...objects.Object) (ret objects.Object, err error) {
 	if len(args) != 2 {
 		err = objects.ErrWrongNumArguments
@@ -567,6 +620,23 @@ func timesTimeWeekday(args ...objects.Object) (ret objects.Object, err error) {
 	return
 }
 
+func timesTimeYearday(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	t1, ok := objects.ToTime(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	ret = &objects.Int{Value: int64(t1.YearDay())}
+
+	return
+}
+
 func timesTimeHour(args ...objects.Object) (ret objects.Object, err error) {
 	if len(args) != 1 {
 		err = objects.ErrWrongNumArguments
@@ -747,6 +817,36 @@ func timesToUTC(args ...objects.Object) (ret objects.Object, err error) {
 	return
 }
 
+func timesToLocation(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 2 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	t1, ok := objects.ToTime(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	s2, ok := objects.ToString(args[1])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	loc, err := time.LoadLocation(s2)
+	if err != nil {
+		ret = wrapError(err)
+		err = nil
+		return
+	}
+
+	ret = &objects.Time{Value: t1.In(loc)}
+
+	return
+}
+
 func timesTimeLocation(args ...objects.Object) (ret objects.Object, err error) {
 	if len(args) != 1 {
 		err = objects.ErrWrongNumArguments
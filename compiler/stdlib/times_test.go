@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/d5/tengo/assert"
+	"github.com/d5/tengo/compiler/stdlib"
 	"github.com/d5/tengo/objects"
 )
 
@@ -20,6 +21,7 @@ func TestTimes(t *testing.T) {
 	module(t, "times").call("parse_duration", "1ns").expect(1)
 	module(t, "times").call("parse_duration", "1ms").expect(1000000)
 	module(t, "times").call("parse_duration", "1h").expect(3600000000000)
+	module(t, "times").call("parse_duration", "1x").expectError()
 	module(t, "times").call("duration_hours", 1800000000000).expect(0.5)
 	module(t, "times").call("duration_minutes", 1800000000000).expect(30.0)
 	module(t, "times").call("duration_nanoseconds", 100).expect(100)
@@ -60,3 +62,93 @@ func TestTimes(t *testing.T) {
 	module(t, "times").call("time_location", time1).expect(time1.Location().String())
 	module(t, "times").call("time_string", time1).expect(time1.String())
 }
+
+func TestTimesFormats(t *testing.T) {
+	time1 := time.Date(1982, 9, 28, 19, 21, 44, 999, time.UTC)
+
+	for name, expected := range map[string]string{
+		"format_rfc3339":   "1982-09-28T19:21:44Z",
+		"format_rfc1123":   "Tue, 28 Sep 1982 19:21:44 UTC",
+		"format_date_only": "1982-09-28",
+		"format_time_only": "19:21:44",
+		"format_datetime":  "1982-09-28 19:21:44",
+	} {
+		format := stdlib.Modules["times"].Value[name]
+		if !assert.NotNil(t, format, name) {
+			continue
+		}
+
+		module(t, "times").call("time_format", time1, format).expect(expected, name)
+	}
+
+	dateOnly := stdlib.Modules["times"].Value["format_date_only"]
+	module(t, "times").call("parse", dateOnly, "1982-09-28").expect(time.Date(1982, 9, 28, 0, 0, 0, 0, time.UTC))
+	datetime := stdlib.Modules["times"].Value["format_datetime"]
+	module(t, "times").call("parse", datetime, "1982-09-28 19:21:44").expect(time.Date(1982, 9, 28, 19, 21, 44, 0, time.UTC))
+}
+
+func TestTimesToLocation(t *testing.T) {
+	time1 := time.Date(1982, 9, 28, 19, 21, 44, 999, time.UTC)
+
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	local := module(t, "times").call("to_location", time1, "Asia/Seoul")
+	local.expect(time1.In(seoul))
+	module(t, "times").call("time_hour", local.o).expect(4)
+	module(t, "times").call("time_day", local.o).expect(29)
+	module(t, "times").call("time_location", local.o).expect("Asia/Seoul")
+	module(t, "times").call("time_format", local.o, time.RFC3339).expect("1982-09-29T04:21:44+09:00")
+
+	// and back
+	utc := module(t, "times").call("to_utc", local.o)
+	utc.expect(time1)
+	module(t, "times").call("time_location", utc.o).expect("UTC")
+	module(t, "times").call("to_location", local.o, "UTC").expect(time1)
+
+	assert.IsType(t, &objects.Error{}, module(t, "times").call("to_location", time1, "Nowhere/City").o)
+	module(t, "times").call("to_location", time1).expectError()
+	module(t, "times").call("to_location", 1.5, "UTC").expectError()
+}
+
+func TestTimesComponents(t *testing.T) {
+	// Sunday, the 61st day of 2020 (a leap year)
+	time1 := time.Date(2020, 3, 1, 13, 4, 5, 123456789, time.UTC)
+
+	module(t, "times").call("time_year", time1).expect(2020)
+	module(t, "times").call("time_month", time1).expect(3)
+	module(t, "times").call("time_day", time1).expect(1)
+	module(t, "times").call("time_hour", time1).expect(13)
+	module(t, "times").call("time_minute", time1).expect(4)
+	module(t, "times").call("time_second", time1).expect(5)
+	module(t, "times").call("time_nanosecond", time1).expect(123456789)
+	module(t, "times").call("time_weekday", time1).expect(0)
+	module(t, "times").call("time_yearday", time1).expect(61)
+
+	module(t, "times").call("time_weekday", time1.AddDate(0, 0, 6)).expect(6)
+	module(t, "times").call("time_yearday", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)).expect(1)
+	module(t, "times").call("time_yearday", time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)).expect(366)
+	module(t, "times").call("time_yearday", time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)).expect(365)
+
+	module(t, "times").call("time_yearday").expectError()
+	module(t, "times").call("time_yearday", "a").expectError()
+}
+
+func TestTimesTruncateRound(t *testing.T) {
+	time1 := time.Date(1982, 9, 28, 19, 21, 44, 999, time.UTC)
+
+	module(t, "times").call("truncate", time1, int64(time.Hour)).expect(time.Date(1982, 9, 28, 19, 0, 0, 0, time.UTC))
+	module(t, "times").call("truncate", time1, int64(time.Minute)).expect(time.Date(1982, 9, 28, 19, 21, 0, 0, time.UTC))
+	module(t, "times").call("truncate", time1, 0).expect(time1)
+
+	module(t, "times").call("round", time1, int64(time.Minute)).expect(time.Date(1982, 9, 28, 19, 22, 0, 0, time.UTC))
+	module(t, "times").call("round", time1.Add(-15*time.Second), int64(time.Minute)).expect(time.Date(1982, 9, 28, 19, 21, 0, 0, time.UTC))
+	module(t, "times").call("round", time.Date(1982, 9, 28, 19, 21, 30, 0, time.UTC), int64(time.Minute)).expect(time.Date(1982, 9, 28, 19, 22, 0, 0, time.UTC))
+	module(t, "times").call("round", time1, int64(time.Hour)).expect(time.Date(1982, 9, 28, 19, 0, 0, 0, time.UTC))
+	module(t, "times").call("round", time1, -1).expect(time1)
+
+	module(t, "times").call("truncate", time1).expectError()
+	module(t, "times").call("round", time1, "1m").expectError()
+}
@@ -0,0 +1,215 @@
+package stdlib
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/d5/tengo/objects"
+)
+
+var urlModule = map[string]objects.Object{
+	"parse":          &objects.UserFunction{Value: urlParse},       // parse(s) => map/error
+	"build":          &objects.UserFunction{Value: urlBuild},       // build(map) => string
+	"query_escape":   FuncASRS(url.QueryEscape),                    // query_escape(s) => string
+	"query_unescape": FuncASRSE(url.QueryUnescape),                 // query_unescape(s) => string/error
+	"path_join":      &objects.UserFunction{Value: pathJoin},       // path_join(parts...) => string
+	"encode_query":   &objects.UserFunction{Value: urlEncodeQuery}, // encode_query(map) => string
+	"decode_query":   &objects.UserFunction{Value: urlDecodeQuery}, // decode_query(s) => map/error
+}
+
+func urlParse(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	s, ok := objects.ToString(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		ret = wrapError(err)
+		err = nil
+		return
+	}
+
+	query := make(map[string]objects.Object)
+	for k, vs := range u.Query() {
+		arr := &objects.Array{}
+		for _, v := range vs {
+			arr.Value = append(arr.Value, &objects.String{Value: v})
+		}
+		query[k] = arr
+	}
+
+	var user string
+	if u.User != nil {
+		user = u.User.Username()
+		if password, ok := u.User.Password(); ok {
+			user += ":" + password
+		}
+	}
+
+	ret = &objects.Map{Value: map[string]objects.Object{
+		"scheme":   &objects.String{Value: u.Scheme},
+		"user":     &objects.String{Value: user},
+		"host":     &objects.String{Value: u.Host},
+		"path":     &objects.String{Value: u.Path},
+		"query":    &objects.Map{Value: query},
+		"fragment": &objects.String{Value: u.Fragment},
+	}}
+
+	return
+}
+
+func urlBuild(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	var m map[string]objects.Object
+	switch arg := args[0].(type) {
+	case *objects.Map:
+		m = arg.Value
+	case *objects.ImmutableMap:
+		m = arg.Value
+	default:
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	var u url.URL
+	for _, f := range []struct {
+		name  string
+		value *string
+	}{
+		{"scheme", &u.Scheme},
+		{"host", &u.Host},
+		{"path", &u.Path},
+		{"fragment", &u.Fragment},
+	} {
+		if v, ok := m[f.name]; ok {
+			if *f.value, ok = objects.ToString(v); !ok {
+				err = objects.ErrInvalidTypeConversion
+				return
+			}
+		}
+	}
+
+	if v, ok := m["user"]; ok {
+		user, ok := objects.ToString(v)
+		if !ok {
+			err = objects.ErrInvalidTypeConversion
+			return
+		}
+
+		if user != "" {
+			// "name" or "name:password"
+			if i := strings.Index(user, ":"); i >= 0 {
+				u.User = url.UserPassword(user[:i], user[i+1:])
+			} else {
+				u.User = url.User(user)
+			}
+		}
+	}
+
+	if v, ok := m["query"]; ok {
+		values, e := urlQueryValues(v)
+		if e != nil {
+			err = e
+			return
+		}
+		u.RawQuery = values.Encode()
+	}
+
+	ret = &objects.String{Value: u.String()}
+
+	return
+}
+
+// urlQueryValues converts a map of the query values. A query value can be
+// a single value or an array of values.
+func urlQueryValues(o objects.Object) (url.Values, error) {
+	var query map[string]objects.Object
+	switch o := o.(type) {
+	case *objects.Map:
+		query = o.Value
+	case *objects.ImmutableMap:
+		query = o.Value
+	default:
+		return nil, objects.ErrInvalidTypeConversion
+	}
+
+	values := make(url.Values)
+	for k, v := range query {
+		var elements []objects.Object
+		switch v := v.(type) {
+		case *objects.Array:
+			elements = v.Value
+		case *objects.ImmutableArray:
+			elements = v.Value
+		default:
+			elements = []objects.Object{v}
+		}
+
+		for _, e := range elements {
+			s, ok := objects.ToString(e)
+			if !ok {
+				return nil, objects.ErrInvalidTypeConversion
+			}
+			values.Add(k, s)
+		}
+	}
+
+	return values, nil
+}
+
+func urlEncodeQuery(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	values, err := urlQueryValues(args[0])
+	if err != nil {
+		return
+	}
+
+	ret = &objects.String{Value: values.Encode()}
+
+	return
+}
+
+func urlDecodeQuery(args ...objects.Object) (ret objects.Object, err error) {
+	if len(args) != 1 {
+		err = objects.ErrWrongNumArguments
+		return
+	}
+
+	s, ok := objects.ToString(args[0])
+	if !ok {
+		err = objects.ErrInvalidTypeConversion
+		return
+	}
+
+	values, err := url.ParseQuery(s)
+	if err != nil {
+		ret = wrapError(err)
+		err = nil
+		return
+	}
+
+	// the last value wins for the repeated keys
+	query := make(map[string]objects.Object)
+	for k, vs := range values {
+		query[k] = &objects.String{Value: vs[len(vs)-1]}
+	}
+
+	ret = &objects.Map{Value: query}
+
+	return
+}
@@ -5,4 +5,5 @@ type Symbol struct {
 	Name  string
 	Scope SymbolScope
 	Index int
+	Const bool // declared with 'const'; cannot be reassigned
 }
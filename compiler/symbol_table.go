@@ -5,6 +5,7 @@ type SymbolTable struct {
 	parent        *SymbolTable
 	block         bool
 	store         map[string]Symbol
+	builtins      map[string]Symbol
 	numDefinition int
 	maxDefinition int
 	freeSymbols   []Symbol
@@ -35,7 +36,19 @@ func (t *SymbolTable) Define(name string) Symbol {
 	return symbol
 }
 
-// DefineBuiltin adds a symbol for builtin function.
+// DefineConst adds a new constant symbol in the current scope.
+func (t *SymbolTable) DefineConst(name string) Symbol {
+	symbol := t.Define(name)
+	symbol.Const = true
+
+	t.store[name] = symbol
+
+	return symbol
+}
+
+// DefineBuiltin adds a symbol for builtin function. Builtin functions are
+// defined in the scope that encloses the current scope, so the variables
+// defined in the current scope can shadow them.
 func (t *SymbolTable) DefineBuiltin(index int, name string) Symbol {
 	symbol := Symbol{
 		Name:  name,
@@ -43,7 +56,10 @@ func (t *SymbolTable) DefineBuiltin(index int, name string) Symbol {
 		Scope: ScopeBuiltin,
 	}
 
-	t.store[name] = symbol
+	if t.builtins == nil {
+		t.builtins = make(map[string]Symbol)
+	}
+	t.builtins[name] = symbol
 
 	return symbol
 }
@@ -51,23 +67,31 @@ func (t *SymbolTable) DefineBuiltin(index int, name string) Symbol {
 // Resolve resolves a symbol with a given name.
 func (t *SymbolTable) Resolve(name string) (symbol Symbol, depth int, ok bool) {
 	symbol, ok = t.store[name]
-	if !ok && t.parent != nil {
+	if ok {
+		return
+	}
+
+	if t.parent != nil {
 		symbol, depth, ok = t.parent.Resolve(name)
-		if !ok {
-			return
-		}
+		if ok {
+			if !t.block {
+				depth++
+			}
 
-		if !t.block {
-			depth++
-		}
+			// if symbol is defined in parent table and if it's not global/builtin
+			// then it's free variable.
+			if depth > 0 && symbol.Scope != ScopeGlobal && symbol.Scope != ScopeBuiltin {
+				return t.defineFree(symbol), depth, true
+			}
 
-		// if symbol is defined in parent table and if it's not global/builtin
-		// then it's free variable.
-		if depth > 0 && symbol.Scope != ScopeGlobal && symbol.Scope != ScopeBuiltin {
-			return t.defineFree(symbol), depth, true
+			return
 		}
+	}
 
-		return
+	// builtin functions are in the enclosing scope
+	symbol, ok = t.builtins[name]
+	if ok {
+		depth = 1
 	}
 
 	return
@@ -137,6 +161,7 @@ func (t *SymbolTable) defineFree(original Symbol) Symbol {
 		Name:  original.Name,
 		Index: len(t.freeSymbols) - 1,
 		Scope: ScopeFree,
+		Const: original.Const,
 	}
 
 	t.store[original.Name] = symbol
@@ -91,6 +91,27 @@ func TestSymbolTable(t *testing.T) {
 	resolveExpect(t, local2Block2, "b", globalSymbol("b", 1), 2)
 }
 
+func TestSymbolTable_Builtins(t *testing.T) {
+	global := symbolTable()
+	global.DefineBuiltin(0, "len")
+	global.DefineBuiltin(1, "count")
+
+	// builtins are resolved from the enclosing scope
+	resolveExpect(t, global, "len", builtinSymbol("len", 0), 1)
+	local := global.Fork(false)
+	resolveExpect(t, local, "len", builtinSymbol("len", 0), 2)
+	resolveExpect(t, local.Fork(true), "len", builtinSymbol("len", 0), 2)
+
+	// global variables shadow the builtins
+	assert.Equal(t, globalSymbol("count", 0), global.Define("count"))
+	resolveExpect(t, global, "count", globalSymbol("count", 0), 0)
+	resolveExpect(t, local, "count", globalSymbol("count", 0), 1)
+	resolveExpect(t, global, "len", builtinSymbol("len", 0), 1)
+	names := global.Names()
+	assert.Equal(t, 1, len(names))
+	assert.Equal(t, "count", names[0])
+}
+
 func symbol(name string, scope compiler.SymbolScope, index int) compiler.Symbol {
 	return compiler.Symbol{
 		Name:  name,
@@ -107,6 +128,10 @@ func localSymbol(name string, index int) compiler.Symbol {
 	return symbol(name, compiler.ScopeLocal, index)
 }
 
+func builtinSymbol(name string, index int) compiler.Symbol {
+	return symbol(name, compiler.ScopeBuiltin, index)
+}
+
 func freeSymbol(name string, index int) compiler.Symbol {
 	return symbol(name, compiler.ScopeFree, index)
 }
@@ -42,6 +42,7 @@ const (
 	AndNotAssign // &^=
 	LAnd         // &&
 	LOr          // ||
+	Arrow        // <-
 	Inc          // ++
 	Dec          // --
 	Equal        // ==
@@ -81,6 +82,10 @@ const (
 	In
 	Undefined
 	Import
+	Const
+	Select
+	Case
+	Default
 	_keywordEnd
 )
 
@@ -117,6 +122,7 @@ var tokens = [...]string{
 	AndNotAssign: "&^=",
 	LAnd:         "&&",
 	LOr:          "||",
+	Arrow:        "<-",
 	Inc:          "++",
 	Dec:          "--",
 	Equal:        "==",
@@ -154,6 +160,10 @@ var tokens = [...]string{
 	In:           "in",
 	Undefined:    "undefined",
 	Import:       "import",
+	Const:        "const",
+	Select:       "select",
+	Case:         "case",
+	Default:      "default",
 }
 
 func (tok Token) String() string {
@@ -17,3 +17,27 @@ func builtinAppend(args ...Object) (Object, error) {
 		return nil, fmt.Errorf("unsupported type for 'append' function: %s", arg.TypeName())
 	}
 }
+
+// extend(array, other) appends all the elements of other to the array
+// and returns the array.
+func builtinExtend(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	arr, ok := args[0].(*Array)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'extend' function: %s", args[0].TypeName())
+	}
+
+	switch other := args[1].(type) {
+	case *Array:
+		arr.Value = append(arr.Value, other.Value...)
+	case *ImmutableArray:
+		arr.Value = append(arr.Value, other.Value...)
+	default:
+		return nil, fmt.Errorf("unsupported type for 'extend' function: %s", other.TypeName())
+	}
+
+	return arr, nil
+}
@@ -0,0 +1,28 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// assert(cond, message) stops the execution with a runtime error carrying
+// the message if cond is falsy and returns undefined otherwise.
+func builtinAssert(args ...Object) (Object, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	if !args[0].IsFalsy() {
+		return UndefinedValue, nil
+	}
+
+	if len(args) == 1 {
+		return nil, fmt.Errorf("assertion failed")
+	}
+
+	message, ok := ToString(args[1])
+	if !ok {
+		message = args[1].String()
+	}
+
+	return nil, fmt.Errorf("assertion failed: %s", message)
+}
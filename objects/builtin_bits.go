@@ -0,0 +1,89 @@
+package objects
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// bitArgs returns the int value and the bit position of the arguments.
+func bitArgs(name string, args []Object) (x int64, n uint, err error) {
+	if len(args) != 2 {
+		return 0, 0, ErrWrongNumArguments
+	}
+
+	xArg, ok := args[0].(*Int)
+	if !ok {
+		return 0, 0, fmt.Errorf("unsupported type for '%s' function: %s", name, args[0].TypeName())
+	}
+
+	nArg, ok := args[1].(*Int)
+	if !ok {
+		return 0, 0, fmt.Errorf("unsupported type for '%s' function: %s", name, args[1].TypeName())
+	}
+
+	if nArg.Value < 0 || nArg.Value > 63 {
+		return 0, 0, fmt.Errorf("bit position out of range: %d", nArg.Value)
+	}
+
+	return xArg.Value, uint(nArg.Value), nil
+}
+
+// bit_set(x, n) returns x with the bit n set.
+func builtinBitSet(args ...Object) (Object, error) {
+	x, n, err := bitArgs("bit_set", args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Int{Value: x | 1<<n}, nil
+}
+
+// bit_clear(x, n) returns x with the bit n cleared.
+func builtinBitClear(args ...Object) (Object, error) {
+	x, n, err := bitArgs("bit_clear", args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Int{Value: x &^ (1 << n)}, nil
+}
+
+// bit_test(x, n) returns true if the bit n of x is set.
+func builtinBitTest(args ...Object) (Object, error) {
+	x, n, err := bitArgs("bit_test", args)
+	if err != nil {
+		return nil, err
+	}
+
+	if x&(1<<n) != 0 {
+		return TrueValue, nil
+	}
+
+	return FalseValue, nil
+}
+
+// bitsFunc returns a builtin function that applies fn
+// to the bits of the int argument.
+func bitsFunc(name string, fn func(x uint64) int) CallableFunc {
+	return func(args ...Object) (Object, error) {
+		if len(args) != 1 {
+			return nil, ErrWrongNumArguments
+		}
+
+		x, ok := args[0].(*Int)
+		if !ok {
+			return nil, fmt.Errorf("unsupported type for '%s' function: %s", name, args[0].TypeName())
+		}
+
+		return &Int{Value: int64(fn(uint64(x.Value)))}, nil
+	}
+}
+
+// popcount(x) returns the number of the set bits of x.
+var builtinPopcount = bitsFunc("popcount", bits.OnesCount64)
+
+// leading_zeros(x) returns the number of the leading zero bits of x.
+var builtinLeadingZeros = bitsFunc("leading_zeros", bits.LeadingZeros64)
+
+// trailing_zeros(x) returns the number of the trailing zero bits of x.
+var builtinTrailingZeros = bitsFunc("trailing_zeros", bits.TrailingZeros64)
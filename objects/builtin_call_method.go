@@ -0,0 +1,44 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// call_method(obj, name, args...) calls the method (a function value) of
+// the object with the given name and returns its return value.
+func builtinCallMethod(rt Interop, args ...Object) (Object, error) {
+	if len(args) < 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	obj, ok := args[0].(Indexable)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'call_method' function: %s", args[0].TypeName())
+	}
+
+	name, ok := args[1].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'call_method' function: %s", args[1].TypeName())
+	}
+
+	method, err := obj.IndexGet(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isCallable(method) {
+		return nil, fmt.Errorf("'%s' is not a method of %s", name.Value, args[0].TypeName())
+	}
+
+	return rt.Call(method, args[2:]...)
+}
+
+// isCallable returns true if the object can be called.
+func isCallable(o Object) bool {
+	switch o.(type) {
+	case *CompiledFunction, *Closure, Callable, InteropCallable:
+		return true
+	}
+
+	return false
+}
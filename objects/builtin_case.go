@@ -0,0 +1,108 @@
+package objects
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// splitWords splits s into the words at the underscores, the hyphens, the
+// whitespaces, and the case changes. An acronym is a single word unless it
+// is followed by a lowercase letter ("HTTPServer" is "HTTP" and "Server").
+func splitWords(s string) []string {
+	var words []string
+	var word []rune
+
+	runes := []rune(s)
+	for i, r := range runes {
+		if r == '_' || r == '-' || unicode.IsSpace(r) {
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = nil
+			}
+			continue
+		}
+
+		if len(word) > 0 && unicode.IsUpper(r) {
+			prev := word[len(word)-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if !unicode.IsUpper(prev) || nextLower {
+				words = append(words, string(word))
+				word = nil
+			}
+		}
+
+		word = append(word, r)
+	}
+
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+
+	return words
+}
+
+// stringFunc returns a builtin function that converts the string argument
+// using fn.
+func stringFunc(name string, fn func(s string) string) CallableFunc {
+	return func(args ...Object) (Object, error) {
+		if len(args) != 1 {
+			return nil, ErrWrongNumArguments
+		}
+
+		s, ok := args[0].(*String)
+		if !ok {
+			return nil, fmt.Errorf("unsupported type for '%s' function: %s", name, args[0].TypeName())
+		}
+
+		return &String{Value: fn(s.Value)}, nil
+	}
+}
+
+// caseFunc returns a builtin function that converts the string argument
+// using the words of the string.
+func caseFunc(name string, fn func(words []string) string) CallableFunc {
+	return stringFunc(name, func(s string) string {
+		return fn(splitWords(s))
+	})
+}
+
+// to_upper(s) returns s with all Unicode letters mapped to their upper case.
+var builtinToUpper = stringFunc("to_upper", strings.ToUpper)
+
+// to_lower(s) returns s with all Unicode letters mapped to their lower case.
+var builtinToLower = stringFunc("to_lower", strings.ToLower)
+
+// fold(s) returns the case-folded s, so that the strings that are equal
+// under simple Unicode case folding have the same result.
+var builtinFold = stringFunc("fold", func(s string) string {
+	return strings.Map(func(r rune) rune {
+		return unicode.ToLower(unicode.ToUpper(r))
+	}, s)
+})
+
+// to_camel(s) converts s to camelCase.
+var builtinToCamel = caseFunc("to_camel", func(words []string) string {
+	var sb strings.Builder
+	for i, w := range words {
+		w = strings.ToLower(w)
+		if i > 0 {
+			r := []rune(w)
+			r[0] = unicode.ToUpper(r[0])
+			w = string(r)
+		}
+		sb.WriteString(w)
+	}
+
+	return sb.String()
+})
+
+// to_snake(s) converts s to snake_case.
+var builtinToSnake = caseFunc("to_snake", func(words []string) string {
+	return strings.ToLower(strings.Join(words, "_"))
+})
+
+// to_kebab(s) converts s to kebab-case.
+var builtinToKebab = caseFunc("to_kebab", func(words []string) string {
+	return strings.ToLower(strings.Join(words, "-"))
+})
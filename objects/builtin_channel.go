@@ -0,0 +1,30 @@
+package objects
+
+import "fmt"
+
+// MaxChannelSize is the maximum buffer size of a channel created by
+// 'channel' function. The buffer is allocated when the channel is created,
+// so a huge size would exhaust the memory.
+const MaxChannelSize = 1 << 16
+
+// channel(size) creates a channel with the optional buffer size. It returns
+// an error object if the size exceeds MaxChannelSize.
+func builtinChannel(args ...Object) (Object, error) {
+	switch len(args) {
+	case 0:
+		return NewChannel(0), nil
+	case 1:
+		size, ok := ToInt64(args[0])
+		if !ok || size < 0 {
+			return nil, ErrInvalidTypeConversion
+		}
+
+		if size > MaxChannelSize {
+			return &Error{Value: &String{Value: fmt.Sprintf("channel size is too large: %d (max: %d)", size, MaxChannelSize)}}, nil
+		}
+
+		return NewChannel(int(size)), nil
+	default:
+		return nil, ErrWrongNumArguments
+	}
+}
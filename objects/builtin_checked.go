@@ -0,0 +1,85 @@
+package objects
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var errIntegerOverflow = errors.New("integer overflow")
+var errDivisionByZero = errors.New("division by zero")
+
+// checkedIntOp returns a builtin function that applies fn to two int
+// arguments. If fn fails, the builtin returns an error object
+// instead of a runtime error.
+func checkedIntOp(name string, fn func(a, b int64) (int64, error)) CallableFunc {
+	return func(args ...Object) (Object, error) {
+		if len(args) != 2 {
+			return nil, ErrWrongNumArguments
+		}
+
+		a, ok := args[0].(*Int)
+		if !ok {
+			return nil, fmt.Errorf("unsupported type for '%s' function: %s", name, args[0].TypeName())
+		}
+
+		b, ok := args[1].(*Int)
+		if !ok {
+			return nil, fmt.Errorf("unsupported type for '%s' function: %s", name, args[1].TypeName())
+		}
+
+		res, err := fn(a.Value, b.Value)
+		if err != nil {
+			return &Error{Value: &String{Value: err.Error()}}, nil
+		}
+
+		return &Int{Value: res}, nil
+	}
+}
+
+// add_checked(a, b) returns a + b, or an error if it overflows.
+var builtinAddChecked = checkedIntOp("add_checked", func(a, b int64) (int64, error) {
+	c := a + b
+	if (c > a) != (b > 0) {
+		return 0, errIntegerOverflow
+	}
+
+	return c, nil
+})
+
+// sub_checked(a, b) returns a - b, or an error if it overflows.
+var builtinSubChecked = checkedIntOp("sub_checked", func(a, b int64) (int64, error) {
+	c := a - b
+	if (c < a) != (b > 0) {
+		return 0, errIntegerOverflow
+	}
+
+	return c, nil
+})
+
+// mul_checked(a, b) returns a * b, or an error if it overflows.
+var builtinMulChecked = checkedIntOp("mul_checked", func(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+
+	c := a * b
+	if (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) || c/b != a {
+		return 0, errIntegerOverflow
+	}
+
+	return c, nil
+})
+
+// div_checked(a, b) returns a / b, or an error if b is zero or it overflows.
+var builtinDivChecked = checkedIntOp("div_checked", func(a, b int64) (int64, error) {
+	if b == 0 {
+		return 0, errDivisionByZero
+	}
+
+	if a == math.MinInt64 && b == -1 {
+		return 0, errIntegerOverflow
+	}
+
+	return a / b, nil
+})
@@ -0,0 +1,40 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// chunk(arr, size) splits arr into the arrays of the given size. The last
+// array can be shorter. It returns an error object if size is not positive.
+func builtinChunk(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	elements, ok := arrayElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'chunk' function: %s", args[0].TypeName())
+	}
+
+	size, ok := args[1].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'chunk' function: %s", args[1].TypeName())
+	}
+
+	if size.Value <= 0 {
+		return &Error{Value: &String{Value: fmt.Sprintf("invalid chunk size: %d", size.Value)}}, nil
+	}
+
+	res := &Array{Value: []Object{}}
+	for len(elements) > 0 {
+		n := len(elements)
+		if int64(n) > size.Value {
+			n = int(size.Value)
+		}
+
+		res.Value = append(res.Value, &Array{Value: append([]Object(nil), elements[:n]...)})
+		elements = elements[n:]
+	}
+
+	return res, nil
+}
@@ -0,0 +1,122 @@
+package objects
+
+import (
+	"fmt"
+	"time"
+)
+
+// now() returns the current local time.
+func builtinNow(args ...Object) (Object, error) {
+	if len(args) != 0 {
+		return nil, ErrWrongNumArguments
+	}
+
+	return &Time{Value: time.Now()}, nil
+}
+
+// unix_now() returns the current Unix time in seconds.
+func builtinUnixNow(args ...Object) (Object, error) {
+	if len(args) != 0 {
+		return nil, ErrWrongNumArguments
+	}
+
+	return &Int{Value: time.Now().Unix()}, nil
+}
+
+// unix_nano() returns the current Unix time in nanoseconds.
+func builtinUnixNano(args ...Object) (Object, error) {
+	if len(args) != 0 {
+		return nil, ErrWrongNumArguments
+	}
+
+	return &Int{Value: time.Now().UnixNano()}, nil
+}
+
+// since(t) returns the nanoseconds elapsed since t.
+func builtinSince(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	t, ok := ToTime(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'since' function: %s", args[0].TypeName())
+	}
+
+	return &Int{Value: int64(time.Since(t))}, nil
+}
+
+// sleep(ns) pauses for the given nanoseconds. It returns early
+// if the execution is aborted.
+func builtinSleep(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	ns, ok := args[0].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'sleep' function: %s", args[0].TypeName())
+	}
+
+	if ns.Value <= 0 {
+		return UndefinedValue, nil
+	}
+
+	pause(rt, time.Duration(ns.Value))
+
+	return UndefinedValue, nil
+}
+
+// parse_duration(s) parses a duration string like "1h30m" and returns
+// the nanoseconds. It returns an error object if s is not valid.
+func builtinParseDuration(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	s, ok := args[0].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'parse_duration' function: %s", args[0].TypeName())
+	}
+
+	d, err := time.ParseDuration(s.Value)
+	if err != nil {
+		return &Error{Value: &String{Value: err.Error()}}, nil
+	}
+
+	return &Int{Value: int64(d)}, nil
+}
+
+// format_duration(ns) returns the string representation of the
+// nanoseconds like "1h30m0s".
+func builtinFormatDuration(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	ns, ok := args[0].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'format_duration' function: %s", args[0].TypeName())
+	}
+
+	return &String{Value: time.Duration(ns.Value).String()}, nil
+}
+
+// timeit(fn, args...) calls fn with the arguments and returns an array of
+// the result and the elapsed nanoseconds.
+func builtinTimeit(rt Interop, args ...Object) (Object, error) {
+	if len(args) < 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	start := time.Now()
+
+	res, err := rt.Call(args[0], args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	elapsed := time.Since(start)
+
+	return &Array{Value: []Object{res, &Int{Value: int64(elapsed)}}}, nil
+}
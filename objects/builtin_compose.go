@@ -0,0 +1,54 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// compose(fns...) returns a function that calls the functions from right
+// to left, passing the return value of each function to the next one.
+func builtinCompose(args ...Object) (Object, error) {
+	return composeFuncs("compose", args, true)
+}
+
+// pipe(fns...) returns a function that calls the functions from left to
+// right, passing the return value of each function to the next one.
+func builtinPipe(args ...Object) (Object, error) {
+	return composeFuncs("pipe", args, false)
+}
+
+func composeFuncs(name string, args []Object, reverse bool) (Object, error) {
+	if len(args) < 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	fns := make([]Object, len(args))
+	for i, fn := range args {
+		if !isCallable(fn) {
+			return nil, fmt.Errorf("unsupported type for '%s' function: %s", name, fn.TypeName())
+		}
+
+		if reverse {
+			fns[len(args)-1-i] = fn
+		} else {
+			fns[i] = fn
+		}
+	}
+
+	return &InteropFunction{Value: func(rt Interop, args ...Object) (Object, error) {
+		if len(args) != 1 {
+			return nil, ErrWrongNumArguments
+		}
+
+		v := args[0]
+		for _, fn := range fns {
+			res, err := rt.Call(fn, v)
+			if err != nil {
+				return nil, err
+			}
+
+			v = res
+		}
+
+		return v, nil
+	}}, nil
+}
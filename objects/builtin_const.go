@@ -0,0 +1,159 @@
+package objects
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+)
+
+// maxConstCacheSize is the maximum number of the cached constants.
+// The cache is cleared when it is full.
+const maxConstCacheSize = 1024
+
+// ConstCache is the cache of the immutable containers created by
+// 'const_map' and 'const_array'. Only the containers of the scalar values
+// are cached, so that they can be shared by the functions of a script.
+// It's safe for the concurrent use.
+type ConstCache struct {
+	lock   sync.Mutex
+	n      int
+	values map[uint64][]Object
+}
+
+// ConstCacher is implemented by the runtimes that cache the constants
+// created by 'const_map' and 'const_array'. The constants are not cached
+// if the runtime does not implement it.
+type ConstCacher interface {
+	ConstCache() *ConstCache
+}
+
+// const_map(k1, v1, k2, v2, ...) creates an immutable map from the
+// alternating keys and values, reusing the same map for the same keys
+// and values if possible.
+func builtinConstMap(rt Interop, args ...Object) (Object, error) {
+	if len(args)%2 != 0 {
+		return nil, ErrWrongNumArguments
+	}
+
+	m := make(map[string]Object, len(args)/2)
+	for i := 0; i < len(args); i += 2 {
+		key, ok := args[i].(*String)
+		if !ok {
+			return nil, fmt.Errorf("invalid key for 'const_map' function: %s", args[i].TypeName())
+		}
+
+		m[key.Value] = args[i+1]
+	}
+
+	return cachedConst(rt, &ImmutableMap{Value: m}), nil
+}
+
+// const_array(items...) creates an immutable array of the items, reusing
+// the same array for the same items if possible.
+func builtinConstArray(rt Interop, args ...Object) (Object, error) {
+	return cachedConst(rt, &ImmutableArray{Value: append([]Object(nil), args...)}), nil
+}
+
+// cachedConst returns the constant in the cache of the runtime that is the
+// same as o, or adds o to the cache if not found. It returns o if it cannot
+// be cached.
+func cachedConst(rt Interop, o Object) Object {
+	cacher, ok := rt.(ConstCacher)
+	if !ok || !isConstValue(o) {
+		return o
+	}
+
+	return cacher.ConstCache().get(o)
+}
+
+// get returns the cached constant that is the same as o, or adds o to the
+// cache if not found.
+func (c *ConstCache) get(o Object) Object {
+	h := fnv.New64a()
+	if err := writeHash(h, o); err != nil {
+		return o
+	}
+	key := h.Sum64()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, v := range c.values[key] {
+		if isSameConst(v, o) {
+			return v
+		}
+	}
+
+	if c.values == nil || c.n >= maxConstCacheSize {
+		c.values = make(map[uint64][]Object)
+		c.n = 0
+	}
+
+	c.values[key] = append(c.values[key], o)
+	c.n++
+
+	return o
+}
+
+// isConstValue returns true if the object is a scalar value or an immutable
+// container of the constant values.
+func isConstValue(o Object) bool {
+	switch o := o.(type) {
+	case *Int, *Float, *String, *Char, *Bool, *Time, *Undefined:
+		return true
+	case *ImmutableArray:
+		for _, e := range o.Value {
+			if !isConstValue(e) {
+				return false
+			}
+		}
+
+		return true
+	case *ImmutableMap:
+		for _, e := range o.Value {
+			if !isConstValue(e) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// isSameConst returns true if the constant values a and b have the same
+// types and values.
+func isSameConst(a, b Object) bool {
+	switch a := a.(type) {
+	case *ImmutableArray:
+		b, ok := b.(*ImmutableArray)
+		if !ok || len(a.Value) != len(b.Value) {
+			return false
+		}
+
+		for i, e := range a.Value {
+			if !isSameConst(e, b.Value[i]) {
+				return false
+			}
+		}
+
+		return true
+	case *ImmutableMap:
+		b, ok := b.(*ImmutableMap)
+		if !ok || len(a.Value) != len(b.Value) {
+			return false
+		}
+
+		for k, e := range a.Value {
+			be, ok := b.Value[k]
+			if !ok || !isSameConst(e, be) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return isSame(a, b)
+}
@@ -1,13 +1,30 @@
 package objects
 
+import (
+	"fmt"
+	"time"
+)
+
+// string(x [, default]) converts the value to its canonical string form.
+// Times are formatted in RFC 3339, and undefined is converted to an empty
+// string or to the default value if given.
 func builtinString(args ...Object) (Object, error) {
 	argsLen := len(args)
 	if !(argsLen == 1 || argsLen == 2) {
 		return nil, ErrWrongNumArguments
 	}
 
-	if _, ok := args[0].(*String); ok {
-		return args[0], nil
+	switch arg := args[0].(type) {
+	case *String:
+		return arg, nil
+	case *Time:
+		return &String{Value: arg.Value.Format(time.RFC3339)}, nil
+	case *Undefined:
+		if argsLen == 2 {
+			return args[1], nil
+		}
+
+		return &String{Value: ""}, nil
 	}
 
 	v, ok := ToString(args[0])
@@ -22,6 +39,10 @@ func builtinString(args ...Object) (Object, error) {
 	return UndefinedValue, nil
 }
 
+// int(x [, default]) converts the value to int. Floats are truncated toward
+// zero, chars are converted to their code points, and bools to 1 or 0.
+// A string that is not a decimal integer is converted to an error or to
+// the default value if given.
 func builtinInt(args ...Object) (Object, error) {
 	argsLen := len(args)
 	if !(argsLen == 1 || argsLen == 2) {
@@ -41,17 +62,33 @@ func builtinInt(args ...Object) (Object, error) {
 		return args[1], nil
 	}
 
+	if str, ok := args[0].(*String); ok {
+		return &Error{Value: &String{Value: fmt.Sprintf("cannot convert string to int: %s", str.String())}}, nil
+	}
+
 	return UndefinedValue, nil
 }
 
+// float(x [, default]) converts the value to float. Chars are converted to
+// their code points, and bools to 1.0 or 0.0. A string that is not a number
+// is converted to an error or to the default value if given.
 func builtinFloat(args ...Object) (Object, error) {
 	argsLen := len(args)
 	if !(argsLen == 1 || argsLen == 2) {
 		return nil, ErrWrongNumArguments
 	}
 
-	if _, ok := args[0].(*Float); ok {
-		return args[0], nil
+	switch arg := args[0].(type) {
+	case *Float:
+		return arg, nil
+	case *Char:
+		return &Float{Value: float64(arg.Value)}, nil
+	case *Bool:
+		if !arg.IsFalsy() {
+			return &Float{Value: 1}, nil
+		}
+
+		return &Float{Value: 0}, nil
 	}
 
 	v, ok := ToFloat64(args[0])
@@ -63,9 +100,15 @@ func builtinFloat(args ...Object) (Object, error) {
 		return args[1], nil
 	}
 
+	if str, ok := args[0].(*String); ok {
+		return &Error{Value: &String{Value: fmt.Sprintf("cannot convert string to float: %s", str.String())}}, nil
+	}
+
 	return UndefinedValue, nil
 }
 
+// bool(x) returns the truthiness of the value, which is the same as
+// the condition evaluation of the VM.
 func builtinBool(args ...Object) (Object, error) {
 	if len(args) != 1 {
 		return nil, ErrWrongNumArguments
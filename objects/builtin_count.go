@@ -0,0 +1,53 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// count(arr, v) returns the number of the elements of arr that equal to v.
+func builtinCount(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	elements, ok := arrayElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'count' function: %s", args[0].TypeName())
+	}
+
+	var n int64
+	for _, e := range elements {
+		if e.Equals(args[1]) {
+			n++
+		}
+	}
+
+	return &Int{Value: n}, nil
+}
+
+// count_by(arr, fn) returns the number of the elements of arr
+// that fn returns a truthy value for.
+func builtinCountBy(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	elements, ok := arrayElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'count_by' function: %s", args[0].TypeName())
+	}
+
+	var n int64
+	for _, e := range elements {
+		res, err := rt.Call(args[1], e)
+		if err != nil {
+			return nil, err
+		}
+
+		if !res.IsFalsy() {
+			n++
+		}
+	}
+
+	return &Int{Value: n}, nil
+}
@@ -0,0 +1,51 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// curry(fn, arity) returns a function that takes one argument per call
+// until it has the given number of arguments, and then calls fn with them.
+// It returns an error object if arity is not positive.
+func builtinCurry(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	fn := args[0]
+	if !isCallable(fn) {
+		return nil, fmt.Errorf("unsupported type for 'curry' function: %s", fn.TypeName())
+	}
+
+	arity, ok := args[1].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'curry' function: %s", args[1].TypeName())
+	}
+
+	if arity.Value < 1 {
+		return &Error{Value: &String{Value: fmt.Sprintf("invalid arity: %d", arity.Value)}}, nil
+	}
+
+	return curried(fn, int(arity.Value), nil), nil
+}
+
+// curried returns a function that appends its argument to the collected
+// arguments. The collected arguments are not modified, so each returned
+// function can be called more than once.
+func curried(fn Object, arity int, collected []Object) Object {
+	return &InteropFunction{Value: func(rt Interop, args ...Object) (Object, error) {
+		if len(args) != 1 {
+			return nil, ErrWrongNumArguments
+		}
+
+		next := make([]Object, len(collected), len(collected)+1)
+		copy(next, collected)
+		next = append(next, args[0])
+
+		if len(next) < arity {
+			return curried(fn, arity, next), nil
+		}
+
+		return rt.Call(fn, next...)
+	}}
+}
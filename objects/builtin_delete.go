@@ -0,0 +1,28 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// delete(m, key) removes the key from the map.
+func builtinDelete(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	key, ok := ToString(args[1])
+	if !ok {
+		return nil, ErrInvalidTypeConversion
+	}
+
+	switch arg := args[0].(type) {
+	case *Map:
+		delete(arg.Value, key)
+	case *OrderedMap:
+		arg.Delete(key)
+	default:
+		return nil, fmt.Errorf("unsupported type for 'delete' function: %s", arg.TypeName())
+	}
+
+	return UndefinedValue, nil
+}
@@ -0,0 +1,25 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// dict(k1, v1, k2, v2, ...) creates a map from the alternating keys and
+// values. The keys must be strings.
+func builtinDict(args ...Object) (Object, error) {
+	if len(args)%2 != 0 {
+		return nil, ErrWrongNumArguments
+	}
+
+	m := make(map[string]Object, len(args)/2)
+	for i := 0; i < len(args); i += 2 {
+		key, ok := args[i].(*String)
+		if !ok {
+			return nil, fmt.Errorf("invalid key for 'dict' function: %s", args[i].TypeName())
+		}
+
+		m[key.Value] = args[i+1]
+	}
+
+	return &Map{Value: m}, nil
+}
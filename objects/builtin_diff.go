@@ -0,0 +1,87 @@
+package objects
+
+import (
+	"fmt"
+	"sort"
+)
+
+// diff(a, b [, recursive]) compares the maps a and b and returns a map
+// of the added keys, the removed keys, and the changed keys with their
+// old and new values.
+func builtinDiff(args ...Object) (Object, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, ErrWrongNumArguments
+	}
+
+	a, ok := mapElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'diff' function: %s", args[0].TypeName())
+	}
+
+	b, ok := mapElements(args[1])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'diff' function: %s", args[1].TypeName())
+	}
+
+	recursive := false
+	if len(args) == 3 {
+		if _, ok := args[2].(*Bool); !ok {
+			return nil, fmt.Errorf("unsupported type for 'diff' function: %s", args[2].TypeName())
+		}
+		recursive = !args[2].IsFalsy()
+	}
+
+	return diffMaps(a, b, recursive), nil
+}
+
+func diffMaps(a, b map[string]Object, recursive bool) *Map {
+	var added, removed []string
+	changed := make(map[string]Object)
+
+	for k, av := range a {
+		bv, ok := b[k]
+		if !ok {
+			removed = append(removed, k)
+			continue
+		}
+
+		if av.Equals(bv) {
+			continue
+		}
+
+		if recursive {
+			am, aIsMap := mapElements(av)
+			bm, bIsMap := mapElements(bv)
+			if aIsMap && bIsMap {
+				changed[k] = diffMaps(am, bm, true)
+				continue
+			}
+		}
+
+		changed[k] = &Array{Value: []Object{av, bv}}
+	}
+
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			added = append(added, k)
+		}
+	}
+
+	return &Map{Value: map[string]Object{
+		"added":   sortedKeyArray(added),
+		"removed": sortedKeyArray(removed),
+		"changed": &Map{Value: changed},
+	}}
+}
+
+// sortedKeyArray returns an array of the sorted keys.
+func sortedKeyArray(keys []string) *Array {
+	sort.Strings(keys)
+
+	arr := &Array{Value: make([]Object, 0, len(keys))}
+	for _, k := range keys {
+		arr.Value = append(arr.Value, &String{Value: k})
+	}
+
+	return arr
+}
@@ -0,0 +1,96 @@
+package objects
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// dump(x) writes an indented representation of x to the output and
+// returns x. A container that contains itself is written as <cycle>.
+func builtinDump(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	var sb strings.Builder
+	dumpObject(&sb, args[0], 0, make(map[Object]bool))
+	sb.WriteByte('\n')
+
+	if _, err := io.WriteString(rt.Output(), sb.String()); err != nil {
+		return nil, err
+	}
+
+	return args[0], nil
+}
+
+// dumpObject writes o at the given depth. visiting holds the containers
+// being written, to detect the cycles.
+func dumpObject(sb *strings.Builder, o Object, depth int, visiting map[Object]bool) {
+	var elements []Object
+	var keys []string
+	var values map[string]Object
+	open, close := "", ""
+
+	switch o := o.(type) {
+	case *Array:
+		elements, open, close = o.Value, "[", "]"
+	case *ImmutableArray:
+		elements, open, close = o.Value, "[", "]"
+	case *Map:
+		values, open, close = o.Value, "{", "}"
+	case *ImmutableMap:
+		values, open, close = o.Value, "{", "}"
+	case *OrderedMap:
+		keys, values, open, close = o.Keys(), o.value, "{", "}"
+	default:
+		sb.WriteString(o.String())
+		return
+	}
+
+	if visiting[o] {
+		sb.WriteString("<cycle>")
+		return
+	}
+
+	if len(elements) == 0 && len(values) == 0 {
+		sb.WriteString(open + close)
+		return
+	}
+
+	visiting[o] = true
+	defer delete(visiting, o)
+
+	if values != nil && keys == nil {
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+	}
+
+	indent := strings.Repeat("  ", depth+1)
+	sb.WriteString(open + "\n")
+	if values != nil {
+		for i, k := range keys {
+			sb.WriteString(indent)
+			sb.WriteString(fmt.Sprintf("%s: ", k))
+			dumpObject(sb, values[k], depth+1, visiting)
+			dumpSeparator(sb, i, len(keys))
+		}
+	} else {
+		for i, e := range elements {
+			sb.WriteString(indent)
+			dumpObject(sb, e, depth+1, visiting)
+			dumpSeparator(sb, i, len(elements))
+		}
+	}
+	sb.WriteString(strings.Repeat("  ", depth) + close)
+}
+
+func dumpSeparator(sb *strings.Builder, i, n int) {
+	if i < n-1 {
+		sb.WriteByte(',')
+	}
+	sb.WriteByte('\n')
+}
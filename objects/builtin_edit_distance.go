@@ -0,0 +1,64 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// edit_distance(a, b) returns the Levenshtein distance between the strings
+// a and b: the minimum number of the single character insertions,
+// deletions and substitutions to change a into b.
+func builtinEditDistance(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	a, ok := args[0].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'edit_distance' function: %s", args[0].TypeName())
+	}
+
+	b, ok := args[1].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'edit_distance' function: %s", args[1].TypeName())
+	}
+
+	return &Int{Value: int64(levenshtein([]rune(a.Value), []rune(b.Value)))}, nil
+}
+
+// levenshtein computes the distance keeping only two rows of the table.
+func levenshtein(a, b []rune) int {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func minInt(v int, others ...int) int {
+	for _, o := range others {
+		if o < v {
+			v = o
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,69 @@
+package objects
+
+import (
+	"fmt"
+	"sort"
+)
+
+// entries(m) returns an array of [key, value] pairs of the map m. The pairs
+// are sorted by the keys or in insertion order for an ordered map.
+func builtinEntries(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	var keys []string
+	var get func(key string) Object
+	if m, ok := args[0].(*OrderedMap); ok {
+		keys = m.Keys()
+		get = func(key string) Object { return m.value[key] }
+	} else {
+		kv, ok := mapElements(args[0])
+		if !ok {
+			return nil, fmt.Errorf("unsupported type for 'entries' function: %s", args[0].TypeName())
+		}
+
+		for k := range kv {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		get = func(key string) Object { return kv[key] }
+	}
+
+	res := &Array{Value: make([]Object, 0, len(keys))}
+	for _, k := range keys {
+		res.Value = append(res.Value, &Array{Value: []Object{&String{Value: k}, get(k)}})
+	}
+
+	return res, nil
+}
+
+// from_entries(arr) creates a map from an array of [key, value] pairs.
+// If a key appears more than once, the last value is used.
+func builtinFromEntries(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	elements, ok := arrayElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'from_entries' function: %s", args[0].TypeName())
+	}
+
+	res := &Map{Value: make(map[string]Object, len(elements))}
+	for _, e := range elements {
+		pair, ok := arrayElements(e)
+		if !ok || len(pair) != 2 {
+			return nil, fmt.Errorf("invalid entry for 'from_entries' function: %s", e.String())
+		}
+
+		key, ok := ToString(pair[0])
+		if !ok {
+			return nil, fmt.Errorf("invalid key for 'from_entries' function: %s", pair[0].TypeName())
+		}
+
+		res.Value[key] = pair[1]
+	}
+
+	return res, nil
+}
@@ -0,0 +1,165 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// errorArg returns the error argument.
+func errorArg(name string, o Object) (*Error, error) {
+	e, ok := o.(*Error)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for '%s' function: %s", name, o.TypeName())
+	}
+
+	return e, nil
+}
+
+// errorMessage returns the message of the error value.
+func errorMessage(e *Error) string {
+	if e.Value == nil {
+		return ""
+	}
+
+	if s, ok := e.Value.(*String); ok {
+		return s.Value
+	}
+
+	return e.Value.String()
+}
+
+// wrap_error(err, message) returns a new error whose message is
+// "message: " followed by the message of err, whose kind is the kind of err
+// and whose cause is err.
+func builtinWrapError(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	cause, err := errorArg("wrap_error", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	message, ok := args[1].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'wrap_error' function: %s", args[1].TypeName())
+	}
+
+	return &Error{
+		Value: &String{Value: message.Value + ": " + errorMessage(cause)},
+		Kind:  cause.Kind,
+		Cause: cause,
+	}, nil
+}
+
+// error_cause(err) returns the error wrapped by err or undefined if err
+// does not wrap an error.
+func builtinErrorCause(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	e, err := errorArg("error_cause", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Cause == nil {
+		return UndefinedValue, nil
+	}
+
+	return e.Cause, nil
+}
+
+// error_with(err, fields) returns a new error with the same message, kind
+// and cause as err, and the fields of err updated with the map fields.
+func builtinErrorWith(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	e, err := errorArg("error_with", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	fields, ok := mapElements(args[1])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'error_with' function: %s", args[1].TypeName())
+	}
+
+	res := &Error{
+		Value:  e.Value,
+		Kind:   e.Kind,
+		Cause:  e.Cause,
+		Fields: make(map[string]Object, len(e.Fields)+len(fields)),
+	}
+	for k, v := range e.Fields {
+		res.Fields[k] = v
+	}
+	for k, v := range fields {
+		res.Fields[k] = v
+	}
+
+	return res, nil
+}
+
+// error_fields(err) returns a new map of the fields of err.
+func builtinErrorFields(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	e, err := errorArg("error_fields", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Map{Value: make(map[string]Object, len(e.Fields))}
+	for k, v := range e.Fields {
+		res.Value[k] = v
+	}
+
+	return res, nil
+}
+
+// error_kind(err) returns the kind of err or undefined if err was created
+// without a kind.
+func builtinErrorKind(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	e, err := errorArg("error_kind", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Kind == "" {
+		return UndefinedValue, nil
+	}
+
+	return &String{Value: e.Kind}, nil
+}
+
+// collect_errors(array) returns a new array of the error elements of the
+// array in the same order.
+func builtinCollectErrors(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	elements, ok := arrayElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'collect_errors' function: %s", args[0].TypeName())
+	}
+
+	res := &Array{Value: []Object{}}
+	for _, e := range elements {
+		if _, isErr := e.(*Error); isErr {
+			res.Value = append(res.Value, e)
+		}
+	}
+
+	return res, nil
+}
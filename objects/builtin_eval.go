@@ -0,0 +1,20 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// eval(src) compiles and runs the source in a new VM and returns
+// the value of the last expression statement.
+func builtinEval(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	src, ok := args[0].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'eval' function: %s", args[0].TypeName())
+	}
+
+	return rt.Eval(src.Value)
+}
@@ -0,0 +1,83 @@
+package objects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// expand(template, map) replaces $key and ${key} in the template with the
+// string values of the map. Tokens with a missing or undefined value are
+// left intact, and $$ is replaced with a literal $.
+func builtinExpand(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	tmpl, ok := args[0].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'expand' function: %s", args[0].TypeName())
+	}
+
+	var lookup func(key string) (Object, bool)
+	if m, ok := args[1].(*OrderedMap); ok {
+		lookup = m.Get
+	} else if m, ok := mapElements(args[1]); ok {
+		lookup = func(key string) (Object, bool) {
+			v, ok := m[key]
+			return v, ok
+		}
+	} else {
+		return nil, fmt.Errorf("unsupported type for 'expand' function: %s", args[1].TypeName())
+	}
+
+	s := tmpl.Value
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 == len(s) {
+			sb.WriteByte(s[i])
+			continue
+		}
+
+		// the length of the token, and the key in the token
+		var n int
+		var key string
+		switch {
+		case s[i+1] == '$':
+			sb.WriteByte('$')
+			i++
+			continue
+		case s[i+1] == '{':
+			end := strings.IndexByte(s[i+2:], '}')
+			if end < 0 {
+				break
+			}
+			key = s[i+2 : i+2+end]
+			n = end + 3
+		default:
+			end := i + 1
+			for end < len(s) && isExpandKeyChar(s[end]) {
+				end++
+			}
+			key = s[i+1 : end]
+			n = end - i
+		}
+
+		if key != "" {
+			if v, ok := lookup(key); ok {
+				if str, ok := ToString(v); ok {
+					sb.WriteString(str)
+					i += n - 1
+					continue
+				}
+			}
+		}
+
+		sb.WriteByte('$')
+	}
+
+	return &String{Value: sb.String()}, nil
+}
+
+func isExpandKeyChar(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
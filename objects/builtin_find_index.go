@@ -0,0 +1,44 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// find_index(arr, fn) returns the index of the first element of arr
+// that fn returns a truthy value for or -1 if there is none.
+func builtinFindIndex(rt Interop, args ...Object) (Object, error) {
+	return findIndex(rt, "find_index", args, false)
+}
+
+// find_last_index(arr, fn) is like find_index, but it searches from the end.
+func builtinFindLastIndex(rt Interop, args ...Object) (Object, error) {
+	return findIndex(rt, "find_last_index", args, true)
+}
+
+func findIndex(rt Interop, name string, args []Object, reverse bool) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	elements, ok := arrayElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for '%s' function: %s", name, args[0].TypeName())
+	}
+
+	for i := range elements {
+		if reverse {
+			i = len(elements) - 1 - i
+		}
+
+		res, err := rt.Call(args[1], elements[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if !res.IsFalsy() {
+			return &Int{Value: int64(i)}, nil
+		}
+	}
+
+	return &Int{Value: -1}, nil
+}
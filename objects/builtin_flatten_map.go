@@ -0,0 +1,96 @@
+package objects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// flatten_map(map) returns a single-level map of the nested map, whose keys
+// are the keys of the nested maps joined with dots (e.g. "a.b.c"). Empty
+// maps and other values (including arrays) are kept as they are.
+func builtinFlattenMap(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	m, ok := mapElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'flatten_map' function: %s", args[0].TypeName())
+	}
+
+	res := make(map[string]Object)
+	if err := flattenMap(res, "", m); err != nil {
+		return nil, err
+	}
+
+	return &Map{Value: res}, nil
+}
+
+func flattenMap(res map[string]Object, prefix string, m map[string]Object) error {
+	for k, v := range m {
+		key := prefix + k
+
+		if nested, ok := mapElements(v); ok && len(nested) > 0 {
+			if err := flattenMap(res, key+".", nested); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if _, exists := res[key]; exists {
+			return fmt.Errorf("duplicate key for 'flatten_map' function: %s", key)
+		}
+
+		res[key] = v
+	}
+
+	return nil
+}
+
+// unflatten_map(map) returns a nested map of the single-level map by
+// splitting its keys at the dots. It is the inverse of flatten_map.
+func builtinUnflattenMap(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	m, ok := mapElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'unflatten_map' function: %s", args[0].TypeName())
+	}
+
+	res := &Map{Value: make(map[string]Object)}
+	created := make(map[*Map]bool) // the nested maps created here
+	for key, v := range m {
+		parts := strings.Split(key, ".")
+
+		cur := res
+		for _, part := range parts[:len(parts)-1] {
+			next, exists := cur.Value[part]
+			if !exists {
+				nextMap := &Map{Value: make(map[string]Object)}
+				created[nextMap] = true
+				cur.Value[part] = nextMap
+				next = nextMap
+			}
+
+			// the values of the source map should not be modified
+			nextMap, ok := next.(*Map)
+			if !ok || !created[nextMap] {
+				return nil, fmt.Errorf("conflicting key for 'unflatten_map' function: %s", key)
+			}
+
+			cur = nextMap
+		}
+
+		last := parts[len(parts)-1]
+		if _, exists := cur.Value[last]; exists {
+			return nil, fmt.Errorf("conflicting key for 'unflatten_map' function: %s", key)
+		}
+
+		cur.Value[last] = v
+	}
+
+	return res, nil
+}
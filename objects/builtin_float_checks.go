@@ -0,0 +1,112 @@
+package objects
+
+import (
+	"fmt"
+	"math"
+)
+
+// floatArg returns the float value of a float or an int argument.
+func floatArg(name string, o Object) (float64, error) {
+	switch o := o.(type) {
+	case *Float:
+		return o.Value, nil
+	case *Int:
+		return float64(o.Value), nil
+	}
+
+	return 0, fmt.Errorf("unsupported type for '%s' function: %s", name, o.TypeName())
+}
+
+// signArg returns the value of the optional sign argument.
+func signArg(name string, args []Object) (int, error) {
+	if len(args) == 0 {
+		return 0, nil
+	}
+
+	sign, ok := args[0].(*Int)
+	if !ok {
+		return 0, fmt.Errorf("unsupported type for '%s' function: %s", name, args[0].TypeName())
+	}
+
+	return int(sign.Value), nil
+}
+
+func boolValue(b bool) Object {
+	if b {
+		return TrueValue
+	}
+
+	return FalseValue
+}
+
+// is_nan(x) returns true if x is NaN.
+func builtinIsNaN(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	x, err := floatArg("is_nan", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return boolValue(math.IsNaN(x)), nil
+}
+
+// is_inf(x, sign) returns true if x is an infinity: positive infinity if
+// sign > 0, negative infinity if sign < 0, or either if sign is 0 or omitted.
+func builtinIsInf(args ...Object) (Object, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	x, err := floatArg("is_inf", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	sign, err := signArg("is_inf", args[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	return boolValue(math.IsInf(x, sign)), nil
+}
+
+// is_finite(x) returns true if x is neither NaN nor an infinity.
+func builtinIsFinite(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	x, err := floatArg("is_finite", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return boolValue(!math.IsNaN(x) && !math.IsInf(x, 0)), nil
+}
+
+// nan() returns NaN.
+func builtinNaN(args ...Object) (Object, error) {
+	if len(args) != 0 {
+		return nil, ErrWrongNumArguments
+	}
+
+	return &Float{Value: math.NaN()}, nil
+}
+
+// inf(sign) returns positive infinity if sign >= 0 or omitted
+// or negative infinity if sign < 0.
+func builtinInf(args ...Object) (Object, error) {
+	if len(args) > 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	sign, err := signArg("inf", args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Float{Value: math.Inf(sign)}, nil
+}
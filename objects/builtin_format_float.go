@@ -0,0 +1,49 @@
+package objects
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// format_float(x, fmt, prec) formats the float value x according to
+// the format ('f', 'e', 'E', 'g' or 'G') and the precision.
+func builtinFormatFloat(args ...Object) (Object, error) {
+	if len(args) != 3 {
+		return nil, ErrWrongNumArguments
+	}
+
+	var x float64
+	switch arg := args[0].(type) {
+	case *Float:
+		x = arg.Value
+	case *Int:
+		x = float64(arg.Value)
+	default:
+		return nil, fmt.Errorf("unsupported type for 'format_float' function: %s", args[0].TypeName())
+	}
+
+	var format rune
+	switch arg := args[1].(type) {
+	case *Char:
+		format = arg.Value
+	case *String:
+		if len(arg.Value) == 1 {
+			format = rune(arg.Value[0])
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type for 'format_float' function: %s", args[1].TypeName())
+	}
+
+	switch format {
+	case 'f', 'e', 'E', 'g', 'G':
+	default:
+		return nil, fmt.Errorf("invalid format for 'format_float' function: %s", args[1].String())
+	}
+
+	prec, ok := args[2].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'format_float' function: %s", args[2].TypeName())
+	}
+
+	return &String{Value: strconv.FormatFloat(x, byte(format), int(prec.Value), 64)}, nil
+}
@@ -6,7 +6,8 @@ import (
 
 // BuiltinFunction represents a builtin function.
 type BuiltinFunction struct {
-	Value CallableFunc
+	Value   CallableFunc
+	Interop InteropFunc // used instead of Value if set
 }
 
 // TypeName returns the name of the type.
@@ -26,7 +27,7 @@ func (o *BuiltinFunction) BinaryOp(op token.Token, rhs Object) (Object, error) {
 
 // Copy returns a copy of the type.
 func (o *BuiltinFunction) Copy() Object {
-	return &BuiltinFunction{Value: o.Value}
+	return &BuiltinFunction{Value: o.Value, Interop: o.Interop}
 }
 
 // IsFalsy returns true if the value of the type is falsy.
@@ -42,5 +43,18 @@ func (o *BuiltinFunction) Equals(x Object) bool {
 
 // Call executes a builtin function.
 func (o *BuiltinFunction) Call(args ...Object) (Object, error) {
+	if o.Interop != nil {
+		return o.Interop(noInterop{}, args...)
+	}
+
+	return o.Value(args...)
+}
+
+// CallInterop executes a builtin function with the access to the runtime.
+func (o *BuiltinFunction) CallInterop(rt Interop, args ...Object) (Object, error) {
+	if o.Interop != nil {
+		return o.Interop(rt, args...)
+	}
+
 	return o.Value(args...)
 }
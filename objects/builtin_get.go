@@ -0,0 +1,33 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// get(m, key, default) returns the value for the key in m or default
+// (undefined if omitted) if the key does not exist.
+func builtinGet(args ...Object) (Object, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, ErrWrongNumArguments
+	}
+
+	kv, ok := mapElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'get' function: %s", args[0].TypeName())
+	}
+
+	key, ok := args[1].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'get' function: %s", args[1].TypeName())
+	}
+
+	if v, ok := kv[key.Value]; ok {
+		return v, nil
+	}
+
+	if len(args) == 3 {
+		return args[2], nil
+	}
+
+	return UndefinedValue, nil
+}
@@ -0,0 +1,60 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// get_in(container, path, default) returns the value at the path of the
+// keys (for maps) and the indices (for arrays) in the container or
+// default (undefined if omitted) if any key or index along the path
+// does not exist or does not match the type of the container.
+func builtinGetIn(args ...Object) (Object, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, ErrWrongNumArguments
+	}
+
+	path, ok := arrayElements(args[1])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'get_in' function: %s", args[1].TypeName())
+	}
+
+	def := Object(UndefinedValue)
+	if len(args) == 3 {
+		def = args[2]
+	}
+
+	v := args[0]
+	for _, key := range path {
+		if v, ok = pathLookup(v, key); !ok {
+			return def, nil
+		}
+	}
+
+	return v, nil
+}
+
+// pathLookup returns the value for the map key or the array index in the
+// container, and whether it exists.
+func pathLookup(container, key Object) (Object, bool) {
+	if kv, ok := mapElements(container); ok {
+		k, ok := key.(*String)
+		if !ok {
+			return nil, false
+		}
+
+		v, ok := kv[k.Value]
+
+		return v, ok
+	}
+
+	if elements, ok := arrayElements(container); ok {
+		idx, ok := key.(*Int)
+		if !ok || idx.Value < 0 || idx.Value >= int64(len(elements)) {
+			return nil, false
+		}
+
+		return elements[idx.Value], true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,15 @@
+package objects
+
+// go(fn, args...) calls fn concurrently and returns a future.
+func builtinGo(rt Interop, args ...Object) (Object, error) {
+	if len(args) < 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	switch fn := args[0].(type) {
+	case *CompiledFunction, *Closure, Callable:
+		return NewFuture(rt, fn, args[1:]...), nil
+	default:
+		return nil, ErrInvalidTypeConversion
+	}
+}
@@ -0,0 +1,41 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// group_by(arr, fn) groups the elements of arr by the keys returned
+// by fn. It returns a map of the keys (as strings) to the arrays of
+// the elements in their original order.
+func builtinGroupBy(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	elements, ok := arrayElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'group_by' function: %s", args[0].TypeName())
+	}
+
+	groups := make(map[string]Object)
+	for _, e := range elements {
+		key, err := rt.Call(args[1], e)
+		if err != nil {
+			return nil, err
+		}
+
+		keyStr, ok := ToString(key)
+		if !ok {
+			return nil, fmt.Errorf("invalid key for 'group_by' function: %s", key.TypeName())
+		}
+
+		group, ok := groups[keyStr].(*Array)
+		if !ok {
+			group = &Array{}
+			groups[keyStr] = group
+		}
+		group.Value = append(group.Value, e)
+	}
+
+	return &Map{Value: groups}, nil
+}
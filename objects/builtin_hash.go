@@ -0,0 +1,161 @@
+package objects
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"sort"
+)
+
+// hash(x) returns a deterministic 64-bit hash of the value. Maps are hashed
+// by their sorted keys, so the order of the keys does not matter.
+func builtinHash(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	h := fnv.New64a()
+	if err := writeHash(h, args[0]); err != nil {
+		return nil, err
+	}
+
+	return &Int{Value: int64(h.Sum64())}, nil
+}
+
+// hash tags distinguish the values of the different types with
+// the same encoding.
+const (
+	hashTagUndefined byte = iota
+	hashTagInt
+	hashTagFloat
+	hashTagString
+	hashTagChar
+	hashTagBool
+	hashTagBytes
+	hashTagTime
+	hashTagArray
+	hashTagMap
+	hashTagSet
+)
+
+// writeHash writes the type tag and the encoded value of the object to h.
+func writeHash(h hash.Hash64, o Object) error {
+	switch o := o.(type) {
+	case *Undefined:
+		writeHashTag(h, hashTagUndefined)
+	case *Int:
+		writeHashTag(h, hashTagInt)
+		writeHashUint(h, uint64(o.Value))
+	case *Float:
+		writeHashTag(h, hashTagFloat)
+		writeHashUint(h, math.Float64bits(o.Value))
+	case *String:
+		writeHashTag(h, hashTagString)
+		writeHashBytes(h, []byte(o.Value))
+	case *Char:
+		writeHashTag(h, hashTagChar)
+		writeHashUint(h, uint64(o.Value))
+	case *Bool:
+		writeHashTag(h, hashTagBool)
+		if o.value {
+			writeHashUint(h, 1)
+		} else {
+			writeHashUint(h, 0)
+		}
+	case *Bytes:
+		writeHashTag(h, hashTagBytes)
+		writeHashBytes(h, o.Value)
+	case *Time:
+		writeHashTag(h, hashTagTime)
+		writeHashUint(h, uint64(o.Value.UnixNano()))
+	case *Array:
+		return writeHashArray(h, o.Value)
+	case *ImmutableArray:
+		return writeHashArray(h, o.Value)
+	case *Map:
+		return writeHashMap(h, o.Value)
+	case *ImmutableMap:
+		return writeHashMap(h, o.Value)
+	case *ReadOnlyMap:
+		return writeHashMap(h, o.Value.Value)
+	case *OrderedMap:
+		m := make(map[string]Object, o.Len())
+		for _, k := range o.Keys() {
+			m[k], _ = o.Get(k)
+		}
+
+		return writeHashMap(h, m)
+	case *Set:
+		// the hashes of the elements are sorted, so the order does not matter
+		var sums []uint64
+		for _, e := range o.Value {
+			eh := fnv.New64a()
+			if err := writeHash(eh, e); err != nil {
+				return err
+			}
+
+			sums = append(sums, eh.Sum64())
+		}
+		sort.Slice(sums, func(i, j int) bool { return sums[i] < sums[j] })
+
+		writeHashTag(h, hashTagSet)
+		writeHashUint(h, uint64(len(sums)))
+		for _, s := range sums {
+			writeHashUint(h, s)
+		}
+	default:
+		return fmt.Errorf("unsupported type for 'hash' function: %s", o.TypeName())
+	}
+
+	return nil
+}
+
+func writeHashArray(h hash.Hash64, elements []Object) error {
+	writeHashTag(h, hashTagArray)
+	writeHashUint(h, uint64(len(elements)))
+	for _, e := range elements {
+		if err := writeHash(h, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeHashMap(h hash.Hash64, m map[string]Object) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	writeHashTag(h, hashTagMap)
+	writeHashUint(h, uint64(len(keys)))
+	for _, k := range keys {
+		writeHashBytes(h, []byte(k))
+		if err := writeHash(h, m[k]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeHashTag(h hash.Hash64, tag byte) {
+	_, _ = h.Write([]byte{tag})
+}
+
+func writeHashUint(h hash.Hash64, v uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	_, _ = h.Write(b[:])
+}
+
+// writeHashBytes writes the length before the bytes, so that, for example,
+// ["ab", "c"] and ["a", "bc"] have different hashes.
+func writeHashBytes(h hash.Hash64, b []byte) {
+	writeHashUint(h, uint64(len(b)))
+	_, _ = h.Write(b)
+}
@@ -0,0 +1,77 @@
+package objects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// indent(s, prefix) adds prefix to the beginning of each non-empty line
+// of s.
+func builtinIndent(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	s, ok := args[0].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'indent' function: %s", args[0].TypeName())
+	}
+
+	prefix, ok := args[1].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'indent' function: %s", args[1].TypeName())
+	}
+
+	lines := strings.Split(s.Value, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix.Value + line
+		}
+	}
+
+	return &String{Value: strings.Join(lines, "\n")}, nil
+}
+
+// dedent(s) removes the longest common leading whitespace of the lines of
+// s. The lines consisting only of whitespaces are not considered, and
+// they are replaced with empty lines.
+func builtinDedent(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	s, ok := args[0].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'dedent' function: %s", args[0].TypeName())
+	}
+
+	lines := strings.Split(s.Value, "\n")
+
+	var common string
+	first := true
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" {
+			lines[i] = ""
+			continue
+		}
+
+		margin := line[:len(line)-len(trimmed)]
+		if first {
+			common, first = margin, false
+			continue
+		}
+
+		n := 0
+		for n < len(common) && n < len(margin) && common[n] == margin[n] {
+			n++
+		}
+		common = common[:n]
+	}
+
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, common)
+	}
+
+	return &String{Value: strings.Join(lines, "\n")}, nil
+}
@@ -0,0 +1,112 @@
+package objects
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// int_to_bytes(x, size, little_endian) encodes the int value into bytes of
+// the given size (1, 2, 4 or 8). The bytes are in big-endian order
+// unless little_endian is true.
+func builtinIntToBytes(args ...Object) (Object, error) {
+	numArgs := len(args)
+	if numArgs != 2 && numArgs != 3 {
+		return nil, ErrWrongNumArguments
+	}
+
+	x, ok := args[0].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'int_to_bytes' function: %s", args[0].TypeName())
+	}
+
+	size, ok := args[1].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'int_to_bytes' function: %s", args[1].TypeName())
+	}
+
+	order, err := byteOrderArg("int_to_bytes", args[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	// the value must fit in either signed or unsigned integer of the size
+	var min, max int64
+	switch size.Value {
+	case 1, 2, 4:
+		bits := uint(size.Value * 8)
+		min, max = -1<<(bits-1), 1<<bits-1
+	case 8:
+		min, max = x.Value, x.Value
+	default:
+		return nil, fmt.Errorf("unsupported size for 'int_to_bytes' function: %d", size.Value)
+	}
+
+	if x.Value < min || x.Value > max {
+		return nil, fmt.Errorf("value %d does not fit in %d bytes", x.Value, size.Value)
+	}
+
+	b := make([]byte, size.Value)
+	switch size.Value {
+	case 1:
+		b[0] = byte(x.Value)
+	case 2:
+		order.PutUint16(b, uint16(x.Value))
+	case 4:
+		order.PutUint32(b, uint32(x.Value))
+	case 8:
+		order.PutUint64(b, uint64(x.Value))
+	}
+
+	return &Bytes{Value: b}, nil
+}
+
+// bytes_to_int(bytes, little_endian) decodes the bytes of size 1, 2, 4 or 8
+// into an unsigned int value. The bytes are in big-endian order
+// unless little_endian is true.
+func builtinBytesToInt(args ...Object) (Object, error) {
+	numArgs := len(args)
+	if numArgs != 1 && numArgs != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	b, ok := args[0].(*Bytes)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'bytes_to_int' function: %s", args[0].TypeName())
+	}
+
+	order, err := byteOrderArg("bytes_to_int", args[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(b.Value) {
+	case 1:
+		return &Int{Value: int64(b.Value[0])}, nil
+	case 2:
+		return &Int{Value: int64(order.Uint16(b.Value))}, nil
+	case 4:
+		return &Int{Value: int64(order.Uint32(b.Value))}, nil
+	case 8:
+		return &Int{Value: int64(order.Uint64(b.Value))}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported size for 'bytes_to_int' function: %d", len(b.Value))
+}
+
+// byteOrderArg returns the byte order for the optional little_endian argument.
+func byteOrderArg(name string, args []Object) (binary.ByteOrder, error) {
+	if len(args) == 0 {
+		return binary.BigEndian, nil
+	}
+
+	little, ok := args[0].(*Bool)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for '%s' function: %s", name, args[0].TypeName())
+	}
+
+	if little.IsFalsy() {
+		return binary.BigEndian, nil
+	}
+
+	return binary.LittleEndian, nil
+}
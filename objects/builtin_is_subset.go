@@ -0,0 +1,32 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// is_subset(a, b) returns true if every key/value pair of the map a is
+// also in the map b. The values are compared using the deep equality.
+func builtinIsSubset(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	a, ok := mapElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'is_subset' function: %s", args[0].TypeName())
+	}
+
+	b, ok := mapElements(args[1])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'is_subset' function: %s", args[1].TypeName())
+	}
+
+	for k, v := range a {
+		bv, ok := b[k]
+		if !ok || !v.Equals(bv) {
+			return FalseValue, nil
+		}
+	}
+
+	return TrueValue, nil
+}
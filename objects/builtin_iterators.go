@@ -0,0 +1,147 @@
+package objects
+
+import (
+	"fmt"
+	"math"
+)
+
+// iterableArg returns a new iterator of the iterable argument.
+func iterableArg(name string, o Object) (Iterator, error) {
+	iterable, ok := o.(Iterable)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for '%s' function: %s", name, o.TypeName())
+	}
+
+	return iterable.Iterate(), nil
+}
+
+// range(stop), range(start, stop) or range(start, stop, step) returns
+// an iterator of the integers from start (inclusive) to stop (exclusive)
+// by step. It returns an error object if step is zero.
+func builtinRange(args ...Object) (Object, error) {
+	if len(args) < 1 || len(args) > 3 {
+		return nil, ErrWrongNumArguments
+	}
+
+	bounds := []int64{0, 0, 1}
+	for i, arg := range args {
+		n, ok := arg.(*Int)
+		if !ok {
+			return nil, fmt.Errorf("unsupported type for 'range' function: %s", arg.TypeName())
+		}
+
+		bounds[i] = n.Value
+	}
+
+	if len(args) == 1 {
+		bounds[0], bounds[1] = 0, bounds[0]
+	}
+
+	start, stop, step := bounds[0], bounds[1], bounds[2]
+	if step == 0 {
+		return &Error{Value: &String{Value: "invalid step: 0"}}, nil
+	}
+
+	idx, cur, done := int64(0), start, false
+
+	return NewLazyIterator(nil, func(Interop) (Object, Object, bool, error) {
+		if done || (step > 0 && cur >= stop) || (step < 0 && cur <= stop) {
+			return nil, nil, false, nil
+		}
+
+		key, value := &Int{Value: idx}, &Int{Value: cur}
+		idx++
+
+		// stop instead of overflowing past the last value
+		if (step > 0 && cur > math.MaxInt64-step) || (step < 0 && cur < math.MinInt64-step) {
+			done = true
+		} else {
+			cur += step
+		}
+
+		return key, value, true, nil
+	}), nil
+}
+
+// lazy_map(iterable, fn) returns an iterator of fn(v) for each value v
+// of iterable with the same keys. fn is called when the element is read.
+func builtinLazyMap(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	it, err := iterableArg("lazy_map", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	fn := args[1]
+
+	return NewLazyIterator(rt, func(rt Interop) (Object, Object, bool, error) {
+		if !iteratorNext(rt, it) {
+			return nil, nil, false, iteratorErr(it)
+		}
+
+		value, err := rt.Call(fn, it.Value())
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		return it.Key(), value, true, nil
+	}), nil
+}
+
+// lazy_filter(iterable, fn) returns an iterator of the elements of
+// iterable for which fn(v) is truthy. fn is called when the element is read.
+func builtinLazyFilter(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	it, err := iterableArg("lazy_filter", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	fn := args[1]
+
+	return NewLazyIterator(rt, func(rt Interop) (Object, Object, bool, error) {
+		for iteratorNext(rt, it) {
+			value := it.Value()
+
+			keep, err := rt.Call(fn, value)
+			if err != nil {
+				return nil, nil, false, err
+			}
+
+			if !keep.IsFalsy() {
+				return it.Key(), value, true, nil
+			}
+		}
+
+		return nil, nil, false, iteratorErr(it)
+	}), nil
+}
+
+// collect(iterable) returns an array of the values of iterable.
+func builtinCollect(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	it, err := iterableArg("collect", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Array{Value: []Object{}}
+	for iteratorNext(rt, it) {
+		res.Value = append(res.Value, it.Value())
+	}
+
+	if err := iteratorErr(it); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
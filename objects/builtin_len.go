@@ -2,25 +2,65 @@ package objects
 
 import (
 	"fmt"
+	"unicode/utf8"
 )
 
+// len(x) returns the number of the characters of a string, the bytes of
+// bytes or the elements of a container. It returns an error object if
+// x has no length.
 func builtinLen(args ...Object) (Object, error) {
 	if len(args) != 1 {
 		return nil, ErrWrongNumArguments
 	}
 
-	switch arg := args[0].(type) {
+	n, ok := objectLen(args[0])
+	if !ok {
+		return &Error{Value: &String{Value: fmt.Sprintf("unsupported type for 'len' function: %s", args[0].TypeName())}}, nil
+	}
+
+	return &Int{Value: int64(n)}, nil
+}
+
+// is_empty(x) returns true if the length of x is 0.
+func builtinIsEmpty(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	n, ok := objectLen(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'is_empty' function: %s", args[0].TypeName())
+	}
+
+	return boolValue(n == 0), nil
+}
+
+// objectLen returns the length of the object, and whether it has a length.
+func objectLen(o Object) (int, bool) {
+	switch o := o.(type) {
 	case *Array:
-		return &Int{Value: int64(len(arg.Value))}, nil
+		return len(o.Value), true
+	case *ImmutableArray:
+		return len(o.Value), true
 	case *String:
-		return &Int{Value: int64(len(arg.Value))}, nil
+		return utf8.RuneCountInString(o.Value), true
 	case *Bytes:
-		return &Int{Value: int64(len(arg.Value))}, nil
+		return len(o.Value), true
 	case *Map:
-		return &Int{Value: int64(len(arg.Value))}, nil
+		return len(o.Value), true
 	case *ImmutableMap:
-		return &Int{Value: int64(len(arg.Value))}, nil
-	default:
-		return nil, fmt.Errorf("unsupported type for 'len' function: %s", arg.TypeName())
+		return len(o.Value), true
+	case *ReadOnlyMap:
+		return len(o.Value.Value), true
+	case *OrderedMap:
+		return o.Len(), true
+	case *Channel:
+		return len(o.Value), true
+	case *Set:
+		return len(o.Value), true
+	case *StringBuilder:
+		return o.Len(), true
 	}
+
+	return 0, false
 }
@@ -0,0 +1,55 @@
+package objects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// lines(s) splits s into the lines separated by "\n" or "\r\n". A final
+// newline does not produce an empty line at the end.
+func builtinLines(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	s, ok := args[0].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'lines' function: %s", args[0].TypeName())
+	}
+
+	res := &Array{Value: []Object{}}
+	if s.Value == "" {
+		return res, nil
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(s.Value, "\n"), "\n") {
+		res.Value = append(res.Value, &String{Value: strings.TrimSuffix(line, "\r")})
+	}
+
+	return res, nil
+}
+
+// unlines(arr) joins the strings of arr, appending "\n" to each of them.
+func builtinUnlines(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	elements, ok := arrayElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'unlines' function: %s", args[0].TypeName())
+	}
+
+	var sb strings.Builder
+	for _, e := range elements {
+		s, ok := e.(*String)
+		if !ok {
+			return nil, fmt.Errorf("unsupported type for 'unlines' function: %s", e.TypeName())
+		}
+
+		sb.WriteString(s.Value)
+		sb.WriteByte('\n')
+	}
+
+	return &String{Value: sb.String()}, nil
+}
@@ -0,0 +1,41 @@
+package objects
+
+import (
+	"sort"
+)
+
+// ordered_map() creates an empty ordered map, and ordered_map(m) creates
+// an ordered map from the map m with the keys in sorted order.
+func builtinOrderedMap(args ...Object) (Object, error) {
+	switch len(args) {
+	case 0:
+		return NewOrderedMap(), nil
+	case 1:
+		var kv map[string]Object
+		switch arg := args[0].(type) {
+		case *Map:
+			kv = arg.Value
+		case *ImmutableMap:
+			kv = arg.Value
+		case *OrderedMap:
+			return arg.Copy(), nil
+		default:
+			return nil, ErrInvalidTypeConversion
+		}
+
+		var keys []string
+		for k := range kv {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		m := NewOrderedMap()
+		for _, k := range keys {
+			m.Set(k, kv[k])
+		}
+
+		return m, nil
+	default:
+		return nil, ErrWrongNumArguments
+	}
+}
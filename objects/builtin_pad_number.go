@@ -0,0 +1,56 @@
+package objects
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// pad_number(x, width, pad) formats the int value x right-aligned in at
+// least width characters, padding with pad ('0' if omitted). The sign of
+// a negative number is placed in front of the padding.
+func builtinPadNumber(args ...Object) (Object, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, ErrWrongNumArguments
+	}
+
+	x, ok := args[0].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'pad_number' function: %s", args[0].TypeName())
+	}
+
+	width, ok := args[1].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'pad_number' function: %s", args[1].TypeName())
+	}
+
+	pad := '0'
+	if len(args) == 3 {
+		switch arg := args[2].(type) {
+		case *Char:
+			pad = arg.Value
+		case *String:
+			r, size := utf8.DecodeRuneInString(arg.Value)
+			if size == 0 || size != len(arg.Value) {
+				return nil, fmt.Errorf("invalid pad character for 'pad_number' function: %s", arg.String())
+			}
+			pad = r
+		default:
+			return nil, fmt.Errorf("unsupported type for 'pad_number' function: %s", args[2].TypeName())
+		}
+	}
+
+	digits := strconv.FormatInt(x.Value, 10)
+	sign := ""
+	if x.Value < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	n := int(width.Value) - len(sign) - len(digits)
+	if n <= 0 {
+		return &String{Value: sign + digits}, nil
+	}
+
+	return &String{Value: sign + strings.Repeat(string(pad), n) + digits}, nil
+}
@@ -0,0 +1,217 @@
+package objects
+
+import (
+	"fmt"
+
+	"github.com/d5/tengo/compiler/ast"
+	"github.com/d5/tengo/compiler/parser"
+	"github.com/d5/tengo/compiler/source"
+	"github.com/d5/tengo/compiler/token"
+)
+
+// parse(src) parses the source without running it and returns a map
+// of the top-level statements, the top-level variables and the imported
+// module names.
+func builtinParse(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	src, ok := args[0].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'parse' function: %s", args[0].TypeName())
+	}
+
+	fileSet := source.NewFileSet()
+	srcFile := fileSet.AddFile("", -1, len(src.Value))
+
+	file, err := parser.NewParser(srcFile, []byte(src.Value), nil).ParseFile()
+	if err != nil {
+		return &Error{Value: &String{Value: err.Error()}}, nil
+	}
+
+	stmts := &Array{}
+	defines := &Array{}
+	imports := &Array{}
+	seenImports := make(map[string]bool)
+
+	for _, stmt := range file.Stmts {
+		if _, ok := stmt.(*ast.EmptyStmt); ok {
+			continue
+		}
+
+		names := &Array{}
+		for _, name := range definedNames(stmt) {
+			names.Value = append(names.Value, &String{Value: name})
+		}
+		defines.Value = append(defines.Value, names.Value...)
+
+		stmts.Value = append(stmts.Value, &Map{Value: map[string]Object{
+			"kind":    &String{Value: stmtKind(stmt)},
+			"line":    &Int{Value: int64(srcFile.Position(stmt.Pos()).Line)},
+			"defines": names,
+		}})
+
+		walkImports(stmt, func(name string) {
+			if !seenImports[name] {
+				seenImports[name] = true
+				imports.Value = append(imports.Value, &String{Value: name})
+			}
+		})
+	}
+
+	return &Map{Value: map[string]Object{
+		"statements": stmts,
+		"defines":    defines,
+		"imports":    imports,
+	}}, nil
+}
+
+// stmtKind returns the kind name of the statement.
+func stmtKind(stmt ast.Stmt) string {
+	switch stmt := stmt.(type) {
+	case *ast.AssignStmt:
+		return "assign"
+	case *ast.ConstStmt:
+		return "const"
+	case *ast.ExprStmt:
+		return "expr"
+	case *ast.IncDecStmt:
+		return "incdec"
+	case *ast.IfStmt:
+		return "if"
+	case *ast.ForStmt:
+		return "for"
+	case *ast.ForInStmt:
+		return "for_in"
+	case *ast.SelectStmt:
+		return "select"
+	case *ast.ReturnStmt:
+		return "return"
+	case *ast.BranchStmt:
+		return stmt.Token.String()
+	case *ast.BlockStmt:
+		return "block"
+	}
+
+	return "bad"
+}
+
+// definedNames returns the names of the variables defined by the statement.
+func definedNames(stmt ast.Stmt) []string {
+	switch stmt := stmt.(type) {
+	case *ast.ConstStmt:
+		return []string{stmt.Ident.Name}
+	case *ast.AssignStmt:
+		if stmt.Token != token.Define {
+			return nil
+		}
+
+		var names []string
+		for _, lhs := range stmt.LHS {
+			switch lhs := lhs.(type) {
+			case *ast.Ident:
+				names = append(names, lhs.Name)
+			case *ast.MapLit:
+				for _, elt := range lhs.Elements {
+					if elt.Value == nil {
+						names = append(names, elt.Key)
+					} else if ident, ok := elt.Value.(*ast.Ident); ok {
+						names = append(names, ident.Name)
+					}
+				}
+			}
+		}
+
+		return names
+	}
+
+	return nil
+}
+
+// walkImports calls fn with the module name of every import expression
+// in the node.
+func walkImports(node ast.Node, fn func(name string)) {
+	switch node := node.(type) {
+	case *ast.ImportExpr:
+		fn(node.ModuleName)
+	case *ast.ExprStmt:
+		walkImports(node.Expr, fn)
+	case *ast.AssignStmt:
+		walkImportsList(node.LHS, fn)
+		walkImportsList(node.RHS, fn)
+	case *ast.ConstStmt:
+		walkImports(node.Value, fn)
+	case *ast.IncDecStmt:
+		walkImports(node.Expr, fn)
+	case *ast.ReturnStmt:
+		walkImports(node.Result, fn)
+	case *ast.BlockStmt:
+		for _, stmt := range node.Stmts {
+			walkImports(stmt, fn)
+		}
+	case *ast.IfStmt:
+		walkImports(node.Init, fn)
+		walkImports(node.Cond, fn)
+		walkImports(node.Body, fn)
+		walkImports(node.Else, fn)
+	case *ast.ForStmt:
+		walkImports(node.Init, fn)
+		walkImports(node.Cond, fn)
+		walkImports(node.Post, fn)
+		walkImports(node.Body, fn)
+	case *ast.ForInStmt:
+		walkImports(node.Iterable, fn)
+		walkImports(node.Body, fn)
+	case *ast.SelectStmt:
+		for _, sc := range node.Cases {
+			walkImports(sc.Chan, fn)
+			walkImports(sc.Value, fn)
+			for _, stmt := range sc.Body {
+				walkImports(stmt, fn)
+			}
+		}
+	case *ast.FuncLit:
+		walkImports(node.Body, fn)
+	case *ast.CallExpr:
+		walkImports(node.Func, fn)
+		walkImportsList(node.Args, fn)
+	case *ast.BinaryExpr:
+		walkImports(node.LHS, fn)
+		walkImports(node.RHS, fn)
+	case *ast.UnaryExpr:
+		walkImports(node.Expr, fn)
+	case *ast.ParenExpr:
+		walkImports(node.Expr, fn)
+	case *ast.CondExpr:
+		walkImports(node.Cond, fn)
+		walkImports(node.True, fn)
+		walkImports(node.False, fn)
+	case *ast.SelectorExpr:
+		walkImports(node.Expr, fn)
+	case *ast.IndexExpr:
+		walkImports(node.Expr, fn)
+		walkImports(node.Index, fn)
+	case *ast.SliceExpr:
+		walkImports(node.Expr, fn)
+		walkImports(node.Low, fn)
+		walkImports(node.High, fn)
+	case *ast.ArrayLit:
+		walkImportsList(node.Elements, fn)
+	case *ast.MapLit:
+		for _, elt := range node.Elements {
+			walkImports(elt.Value, fn)
+		}
+	case *ast.ErrorExpr:
+		walkImports(node.Expr, fn)
+		walkImports(node.Kind, fn)
+	case *ast.ImmutableExpr:
+		walkImports(node.Expr, fn)
+	}
+}
+
+func walkImportsList(exprs []ast.Expr, fn func(name string)) {
+	for _, expr := range exprs {
+		walkImports(expr, fn)
+	}
+}
@@ -0,0 +1,28 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// partial(fn, args...) returns a function that calls fn with the bound
+// arguments followed by the arguments it is called with.
+func builtinPartial(args ...Object) (Object, error) {
+	if len(args) < 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	fn := args[0]
+	if !isCallable(fn) {
+		return nil, fmt.Errorf("unsupported type for 'partial' function: %s", fn.TypeName())
+	}
+
+	bound := append([]Object{}, args[1:]...)
+
+	return &InteropFunction{Value: func(rt Interop, args ...Object) (Object, error) {
+		callArgs := make([]Object, 0, len(bound)+len(args))
+		callArgs = append(callArgs, bound...)
+		callArgs = append(callArgs, args...)
+
+		return rt.Call(fn, callArgs...)
+	}}, nil
+}
@@ -0,0 +1,34 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// partition(arr, fn) splits the elements of arr into the ones that fn
+// returns a truthy value for and the rest. It returns [matches, rest].
+func builtinPartition(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	elements, ok := arrayElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'partition' function: %s", args[0].TypeName())
+	}
+
+	matches, rest := &Array{Value: []Object{}}, &Array{Value: []Object{}}
+	for _, e := range elements {
+		res, err := rt.Call(args[1], e)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.IsFalsy() {
+			rest.Value = append(rest.Value, e)
+		} else {
+			matches.Value = append(matches.Value, e)
+		}
+	}
+
+	return &Array{Value: []Object{matches, rest}}, nil
+}
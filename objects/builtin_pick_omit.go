@@ -0,0 +1,66 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// pick(m, keys) returns a new map with only the given keys of m.
+func builtinPick(args ...Object) (Object, error) {
+	kv, keys, err := pickOmitArgs("pick", args)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Map{Value: make(map[string]Object)}
+	for k := range keys {
+		if v, ok := kv[k]; ok {
+			res.Value[k] = v
+		}
+	}
+
+	return res, nil
+}
+
+// omit(m, keys) returns a new map without the given keys of m.
+func builtinOmit(args ...Object) (Object, error) {
+	kv, keys, err := pickOmitArgs("omit", args)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Map{Value: make(map[string]Object)}
+	for k, v := range kv {
+		if !keys[k] {
+			res.Value[k] = v
+		}
+	}
+
+	return res, nil
+}
+
+func pickOmitArgs(name string, args []Object) (map[string]Object, map[string]bool, error) {
+	if len(args) != 2 {
+		return nil, nil, ErrWrongNumArguments
+	}
+
+	kv, ok := mapElements(args[0])
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported type for '%s' function: %s", name, args[0].TypeName())
+	}
+
+	elements, ok := arrayElements(args[1])
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported type for '%s' function: %s", name, args[1].TypeName())
+	}
+
+	keys := make(map[string]bool, len(elements))
+	for _, e := range elements {
+		key, ok := ToString(e)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid key for '%s' function: %s", name, e.TypeName())
+		}
+		keys[key] = true
+	}
+
+	return kv, keys, nil
+}
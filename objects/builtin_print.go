@@ -5,12 +5,12 @@ import (
 )
 
 // print(args...)
-func builtinPrint(args ...Object) (Object, error) {
+func builtinPrint(rt Interop, args ...Object) (Object, error) {
 	for _, arg := range args {
 		if str, ok := arg.(*String); ok {
-			fmt.Println(str.Value)
+			fmt.Fprintln(rt.Output(), str.Value)
 		} else {
-			fmt.Println(arg.String())
+			fmt.Fprintln(rt.Output(), arg.String())
 		}
 	}
 
@@ -18,7 +18,7 @@ func builtinPrint(args ...Object) (Object, error) {
 }
 
 // printf("format", args...)
-func builtinPrintf(args ...Object) (Object, error) {
+func builtinPrintf(rt Interop, args ...Object) (Object, error) {
 	numArgs := len(args)
 	if numArgs == 0 {
 		return nil, ErrWrongNumArguments
@@ -29,7 +29,7 @@ func builtinPrintf(args ...Object) (Object, error) {
 		return nil, ErrInvalidTypeConversion
 	}
 	if numArgs == 1 {
-		fmt.Print(format)
+		fmt.Fprint(rt.Output(), format)
 		return nil, nil
 	}
 
@@ -38,7 +38,7 @@ func builtinPrintf(args ...Object) (Object, error) {
 		formatArgs[idx] = objectToInterface(arg)
 	}
 
-	fmt.Printf(format.Value, formatArgs...)
+	fmt.Fprintf(rt.Output(), format.Value, formatArgs...)
 
 	return nil, nil
 }
@@ -0,0 +1,63 @@
+package objects
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// encode_query(map) returns the URL-encoded query string of the map with
+// the keys sorted. A value can be a single value or an array of values.
+func builtinEncodeQuery(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	m, ok := mapElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'encode_query' function: %s", args[0].TypeName())
+	}
+
+	values := make(url.Values, len(m))
+	for k, v := range m {
+		elements, ok := arrayElements(v)
+		if !ok {
+			elements = []Object{v}
+		}
+
+		for _, e := range elements {
+			s, ok := ToString(e)
+			if !ok {
+				return nil, fmt.Errorf("unsupported query value for 'encode_query' function: %s", e.TypeName())
+			}
+			values.Add(k, s)
+		}
+	}
+
+	return &String{Value: values.Encode()}, nil
+}
+
+// decode_query(s) parses the URL-encoded query string and returns a map of
+// the keys to the values. The last value wins for the repeated keys. It
+// returns an error object if s is not valid.
+func builtinDecodeQuery(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	s, ok := args[0].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'decode_query' function: %s", args[0].TypeName())
+	}
+
+	values, err := url.ParseQuery(s.Value)
+	if err != nil {
+		return &Error{Value: &String{Value: err.Error()}}, nil
+	}
+
+	res := make(map[string]Object, len(values))
+	for k, vs := range values {
+		res[k] = &String{Value: vs[len(vs)-1]}
+	}
+
+	return &Map{Value: res}, nil
+}
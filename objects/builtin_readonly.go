@@ -0,0 +1,21 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// readonly(map) returns a read-only view of the map without copying it.
+func builtinReadOnly(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	switch arg := args[0].(type) {
+	case *Map:
+		return &ReadOnlyMap{Value: arg}, nil
+	case *ReadOnlyMap, *ImmutableMap:
+		return arg, nil
+	default:
+		return nil, fmt.Errorf("unsupported type for 'readonly' function: %s", arg.TypeName())
+	}
+}
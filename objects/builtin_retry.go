@@ -0,0 +1,63 @@
+package objects
+
+import (
+	"fmt"
+	"time"
+)
+
+// retry(fn, attempts, delay) calls fn until it returns a value that is not
+// an error object, up to the given number of attempts, pausing for delay
+// nanoseconds between the attempts. It returns the last error object if
+// all attempts fail or if the execution is aborted while pausing.
+func builtinRetry(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 3 {
+		return nil, ErrWrongNumArguments
+	}
+
+	if !isCallable(args[0]) {
+		return nil, fmt.Errorf("unsupported type for 'retry' function: %s", args[0].TypeName())
+	}
+
+	attempts, ok := args[1].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'retry' function: %s", args[1].TypeName())
+	}
+
+	delay, ok := args[2].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'retry' function: %s", args[2].TypeName())
+	}
+
+	if attempts.Value < 1 {
+		return &Error{Value: &String{Value: fmt.Sprintf("invalid attempts: %d", attempts.Value)}}, nil
+	}
+
+	for i := int64(1); ; i++ {
+		res, err := rt.Call(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		if _, isErr := res.(*Error); !isErr || i >= attempts.Value {
+			return res, nil
+		}
+
+		if delay.Value > 0 && !pause(rt, time.Duration(delay.Value)) {
+			return res, nil
+		}
+	}
+}
+
+// pause waits for the duration and returns false if the execution is
+// aborted before that.
+func pause(rt Interop, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-rt.Done():
+		return false
+	}
+}
@@ -0,0 +1,70 @@
+package objects
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// round_to(x, places) returns x rounded to the given number of decimal
+// places, rounding half away from zero. Negative places round to the left
+// of the decimal point (tens, hundreds, ...).
+func builtinRoundTo(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	x, err := floatArg("round_to", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	places, ok := args[1].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'round_to' function: %s", args[1].TypeName())
+	}
+
+	return &Float{Value: roundTo(x, places.Value)}, nil
+}
+
+// roundTo rounds the shortest decimal representation of x, so that
+// the values like 2.675 (2.67499999... in binary) are rounded up as written.
+func roundTo(x float64, places int64) float64 {
+	if math.IsNaN(x) || math.IsInf(x, 0) || x == 0 {
+		return x
+	}
+
+	// a float64 has no digits beyond these places
+	if places > 400 {
+		return x
+	} else if places < -400 {
+		return math.Copysign(0, x)
+	}
+
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(x, 'g', -1, 64))
+
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(abs64(places)), nil))
+	if places < 0 {
+		scale.Inv(scale)
+	}
+	r.Mul(r, scale)
+
+	// round half away from zero
+	q, m := new(big.Int).QuoRem(new(big.Int).Abs(r.Num()), r.Denom(), new(big.Int))
+	if m.Lsh(m, 1).Cmp(r.Denom()) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+
+	res, _ := new(big.Rat).Quo(new(big.Rat).SetInt(q), scale).Float64()
+
+	return math.Copysign(res, x)
+}
+
+func abs64(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
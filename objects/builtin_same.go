@@ -0,0 +1,44 @@
+package objects
+
+// same(a, b) returns true if a and b are the same object. The scalar values
+// (int, float, string, char, bool, bytes, time and undefined) are the same
+// if they have the same type and value. Times are the same if they have the
+// same instant and location.
+func builtinSame(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	return boolValue(isSame(args[0], args[1])), nil
+}
+
+func isSame(a, b Object) bool {
+	switch a := a.(type) {
+	case *Int:
+		b, ok := b.(*Int)
+		return ok && a.Value == b.Value
+	case *Float:
+		b, ok := b.(*Float)
+		return ok && a.Value == b.Value
+	case *String:
+		b, ok := b.(*String)
+		return ok && a.Value == b.Value
+	case *Char:
+		b, ok := b.(*Char)
+		return ok && a.Value == b.Value
+	case *Bool:
+		b, ok := b.(*Bool)
+		return ok && a.value == b.value
+	case *Bytes:
+		b, ok := b.(*Bytes)
+		return ok && string(a.Value) == string(b.Value)
+	case *Time:
+		b, ok := b.(*Time)
+		return ok && a.Value.Equal(b.Value) && a.Value.Location().String() == b.Value.Location().String()
+	case *Undefined:
+		_, ok := b.(*Undefined)
+		return ok
+	}
+
+	return a == b
+}
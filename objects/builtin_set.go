@@ -0,0 +1,22 @@
+package objects
+
+// set(array) creates a set from the elements of the array.
+func builtinSet(args ...Object) (Object, error) {
+	switch len(args) {
+	case 0:
+		return NewSet()
+	case 1:
+		switch arg := args[0].(type) {
+		case *Array:
+			return NewSet(arg.Value...)
+		case *ImmutableArray:
+			return NewSet(arg.Value...)
+		case *Set:
+			return arg.Copy(), nil
+		default:
+			return nil, ErrInvalidTypeConversion
+		}
+	default:
+		return nil, ErrWrongNumArguments
+	}
+}
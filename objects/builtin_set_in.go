@@ -0,0 +1,115 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// set_in(container, path, value) sets the value at the path of the keys
+// (for maps) and the indices (for arrays) in the container and returns
+// the container. Missing or undefined intermediate values are created as
+// maps. The containers along the path must be mutable.
+func builtinSetIn(args ...Object) (Object, error) {
+	if len(args) != 3 {
+		return nil, ErrWrongNumArguments
+	}
+
+	switch args[0].(type) {
+	case *Map, *Array:
+	default:
+		return nil, fmt.Errorf("unsupported type for 'set_in' function: %s", args[0].TypeName())
+	}
+
+	path, ok := arrayElements(args[1])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'set_in' function: %s", args[1].TypeName())
+	}
+
+	if len(path) == 0 {
+		return nil, fmt.Errorf("empty path for 'set_in' function")
+	}
+
+	container := args[0]
+	for _, key := range path[:len(path)-1] {
+		child, err := pathGet(container, key)
+		if err != nil {
+			return nil, err
+		}
+
+		if child == UndefinedValue {
+			child = &Map{Value: make(map[string]Object)}
+			if err := pathSet(container, key, child); err != nil {
+				return nil, err
+			}
+		}
+
+		container = child
+	}
+
+	if err := pathSet(container, path[len(path)-1], args[2]); err != nil {
+		return nil, err
+	}
+
+	return args[0], nil
+}
+
+// pathGet returns the value for the map key or the array index in the
+// mutable container. A map key that does not exist returns undefined.
+func pathGet(container, key Object) (Object, error) {
+	switch c := container.(type) {
+	case *Map:
+		k, ok := key.(*String)
+		if !ok {
+			return nil, ErrInvalidIndexType
+		}
+
+		if v, ok := c.Value[k.Value]; ok {
+			return v, nil
+		}
+
+		return UndefinedValue, nil
+	case *Array:
+		idx, ok := key.(*Int)
+		if !ok {
+			return nil, ErrInvalidIndexType
+		}
+
+		if idx.Value < 0 || idx.Value >= int64(len(c.Value)) {
+			return nil, ErrIndexOutOfBounds
+		}
+
+		return c.Value[idx.Value], nil
+	}
+
+	return nil, ErrNotIndexAssignable
+}
+
+// pathSet sets the value for the map key or the array index in the
+// mutable container.
+func pathSet(container, key, value Object) error {
+	switch c := container.(type) {
+	case *Map:
+		k, ok := key.(*String)
+		if !ok {
+			return ErrInvalidIndexType
+		}
+
+		c.Value[k.Value] = value
+
+		return nil
+	case *Array:
+		idx, ok := key.(*Int)
+		if !ok {
+			return ErrInvalidIndexType
+		}
+
+		if idx.Value < 0 || idx.Value >= int64(len(c.Value)) {
+			return ErrIndexOutOfBounds
+		}
+
+		c.Value[idx.Value] = value
+
+		return nil
+	}
+
+	return ErrNotIndexAssignable
+}
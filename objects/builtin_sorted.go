@@ -0,0 +1,74 @@
+package objects
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/d5/tengo/compiler/token"
+)
+
+// sorted(arr, less) returns a new array of the elements of arr sorted in
+// ascending order or using the optional less(a, b) function that returns
+// a truthy value if a should be placed before b. The sort is stable.
+func builtinSorted(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	elements, ok := arrayElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'sorted' function: %s", args[0].TypeName())
+	}
+
+	less := lessThan
+	if len(args) == 2 {
+		less = func(a, b Object) (bool, error) {
+			res, err := rt.Call(args[1], a, b)
+			if err != nil {
+				return false, err
+			}
+
+			return !res.IsFalsy(), nil
+		}
+	}
+
+	res := append([]Object{}, elements...)
+
+	var sortErr error
+	sort.SliceStable(res, func(i, j int) bool {
+		if sortErr != nil {
+			return false
+		}
+
+		l, err := less(res[i], res[j])
+		if err != nil {
+			sortErr = err
+		}
+
+		return l
+	})
+
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	return &Array{Value: res}, nil
+}
+
+// lessThan returns true if a is less than b. Strings are compared
+// lexicographically, and the other values are compared using the '<'
+// operator.
+func lessThan(a, b Object) (bool, error) {
+	if a, ok := a.(*String); ok {
+		if b, ok := b.(*String); ok {
+			return a.Value < b.Value, nil
+		}
+	}
+
+	res, err := a.BinaryOp(token.Less, b)
+	if err != nil {
+		return false, fmt.Errorf("invalid operation: %s < %s", a.TypeName(), b.TypeName())
+	}
+
+	return !res.IsFalsy(), nil
+}
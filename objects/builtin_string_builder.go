@@ -0,0 +1,9 @@
+package objects
+
+// builder(values...) creates a string builder with the values appended.
+func builtinBuilder(args ...Object) (Object, error) {
+	b := &StringBuilder{}
+	b.Append(args...)
+
+	return b, nil
+}
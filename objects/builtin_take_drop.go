@@ -0,0 +1,68 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// take(arr, n) returns the first n elements of arr
+// or the last -n elements if n is negative.
+func builtinTake(args ...Object) (Object, error) {
+	elements, n, err := takeDropArgs("take", args)
+	if err != nil {
+		return nil, err
+	}
+
+	if n >= 0 {
+		return newArraySlice(elements[:n]), nil
+	}
+
+	return newArraySlice(elements[len(elements)+n:]), nil
+}
+
+// drop(arr, n) returns the elements of arr except the first n elements
+// or except the last -n elements if n is negative.
+func builtinDrop(args ...Object) (Object, error) {
+	elements, n, err := takeDropArgs("drop", args)
+	if err != nil {
+		return nil, err
+	}
+
+	if n >= 0 {
+		return newArraySlice(elements[n:]), nil
+	}
+
+	return newArraySlice(elements[:len(elements)+n]), nil
+}
+
+// takeDropArgs returns the elements and the count clamped
+// to the number of the elements.
+func takeDropArgs(name string, args []Object) ([]Object, int, error) {
+	if len(args) != 2 {
+		return nil, 0, ErrWrongNumArguments
+	}
+
+	elements, ok := arrayElements(args[0])
+	if !ok {
+		return nil, 0, fmt.Errorf("unsupported type for '%s' function: %s", name, args[0].TypeName())
+	}
+
+	n, ok := args[1].(*Int)
+	if !ok {
+		return nil, 0, fmt.Errorf("unsupported type for '%s' function: %s", name, args[1].TypeName())
+	}
+
+	l := int64(len(elements))
+	switch {
+	case n.Value > l:
+		return elements, int(l), nil
+	case n.Value < -l:
+		return elements, int(-l), nil
+	}
+
+	return elements, int(n.Value), nil
+}
+
+// newArraySlice creates an array with a copy of the elements.
+func newArraySlice(elements []Object) *Array {
+	return &Array{Value: append([]Object{}, elements...)}
+}
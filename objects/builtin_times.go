@@ -0,0 +1,34 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// times(n, fn) calls fn(i) for i in 0..n-1 and returns an array of
+// the results. It returns an error object if n is negative.
+func builtinTimes(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	n, ok := args[0].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'times' function: %s", args[0].TypeName())
+	}
+
+	if n.Value < 0 {
+		return &Error{Value: &String{Value: fmt.Sprintf("invalid count: %d", n.Value)}}, nil
+	}
+
+	res := &Array{Value: []Object{}}
+	for i := int64(0); i < n.Value; i++ {
+		v, err := rt.Call(args[1], &Int{Value: i})
+		if err != nil {
+			return nil, err
+		}
+
+		res.Value = append(res.Value, v)
+	}
+
+	return res, nil
+}
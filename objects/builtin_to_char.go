@@ -0,0 +1,27 @@
+package objects
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// to_char(s) returns the first character of the string s. It returns an
+// error object if s is empty.
+func builtinToChar(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	s, ok := args[0].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'to_char' function: %s", args[0].TypeName())
+	}
+
+	if s.Value == "" {
+		return &Error{Value: &String{Value: "empty string"}}, nil
+	}
+
+	r, _ := utf8.DecodeRuneInString(s.Value)
+
+	return &Char{Value: r}, nil
+}
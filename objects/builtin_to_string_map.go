@@ -0,0 +1,48 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// to_string_map(map) returns a new map with all the values converted to
+// strings using the rules of 'string' function. The values of the nested
+// maps and the elements of the arrays are converted too, but only one
+// level deep.
+func builtinToStringMap(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	m, ok := mapElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'to_string_map' function: %s", args[0].TypeName())
+	}
+
+	res := make(map[string]Object, len(m))
+	for k, v := range m {
+		if nested, ok := mapElements(v); ok {
+			values := make(map[string]Object, len(nested))
+			for nk, nv := range nested {
+				values[nk] = toStringValue(nv)
+			}
+			res[k] = &Map{Value: values}
+		} else if elements, ok := arrayElements(v); ok {
+			values := make([]Object, 0, len(elements))
+			for _, e := range elements {
+				values = append(values, toStringValue(e))
+			}
+			res[k] = &Array{Value: values}
+		} else {
+			res[k] = toStringValue(v)
+		}
+	}
+
+	return &Map{Value: res}, nil
+}
+
+// toStringValue converts the value to a string like 'string' function.
+func toStringValue(o Object) Object {
+	s, _ := builtinString(o)
+
+	return s
+}
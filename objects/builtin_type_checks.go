@@ -132,6 +132,18 @@ func builtinIsTime(args ...Object) (Object, error) {
 	return FalseValue, nil
 }
 
+func builtinIsSet(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	if _, ok := args[0].(*Set); ok {
+		return TrueValue, nil
+	}
+
+	return FalseValue, nil
+}
+
 func builtinIsError(args ...Object) (Object, error) {
 	if len(args) != 1 {
 		return nil, ErrWrongNumArguments
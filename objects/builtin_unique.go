@@ -0,0 +1,50 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// unique(arr) returns a new array of the elements of arr
+// without the duplicates, keeping the first occurrences.
+func builtinUnique(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	elements, ok := arrayElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'unique' function: %s", args[0].TypeName())
+	}
+
+	seen := make(map[interface{}]bool)
+	var others []Object // non-hashable elements are compared one by one
+	res := &Array{Value: []Object{}}
+	for _, e := range elements {
+		if key, ok := hashKey(e); ok {
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+		} else {
+			if containsEqual(others, e) {
+				continue
+			}
+			others = append(others, e)
+		}
+
+		res.Value = append(res.Value, e)
+	}
+
+	return res, nil
+}
+
+// containsEqual returns true if any of the elements equals to o.
+func containsEqual(elements []Object, o Object) bool {
+	for _, e := range elements {
+		if e.Equals(o) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,82 @@
+package objects
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// utf8BOM is the byte order mark encoded in UTF-8.
+const utf8BOM = "\xef\xbb\xbf"
+
+// strip_bom(s) removes the leading UTF-8 byte order mark of the string
+// or the bytes s, if any.
+func builtinStripBOM(args ...Object) (Object, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	switch arg := args[0].(type) {
+	case *String:
+		if strings.HasPrefix(arg.Value, utf8BOM) {
+			return &String{Value: arg.Value[len(utf8BOM):]}, nil
+		}
+
+		return arg, nil
+	case *Bytes:
+		if bytes.HasPrefix(arg.Value, []byte(utf8BOM)) {
+			return &Bytes{Value: append([]byte{}, arg.Value[len(utf8BOM):]...)}, nil
+		}
+
+		return arg, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type for 'strip_bom' function: %s", args[0].TypeName())
+}
+
+// utf8Arg returns the bytes of the bytes or the string argument.
+func utf8Arg(name string, args []Object) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, ErrWrongNumArguments
+	}
+
+	switch arg := args[0].(type) {
+	case *Bytes:
+		return arg.Value, nil
+	case *String:
+		return []byte(arg.Value), nil
+	}
+
+	return nil, fmt.Errorf("unsupported type for '%s' function: %s", name, args[0].TypeName())
+}
+
+// is_valid_utf8(b) returns true if the bytes or the string b consists
+// entirely of valid UTF-8 encoded characters.
+func builtinIsValidUTF8(args ...Object) (Object, error) {
+	b, err := utf8Arg("is_valid_utf8", args)
+	if err != nil {
+		return nil, err
+	}
+
+	return boolValue(utf8.Valid(b)), nil
+}
+
+// utf8_error_at(b) returns the byte offset of the first invalid UTF-8
+// sequence in the bytes or the string b or -1 if b is valid.
+func builtinUTF8ErrorAt(args ...Object) (Object, error) {
+	b, err := utf8Arg("utf8_error_at", args)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(b); {
+		r, size := utf8.DecodeRune(b[i:])
+		if r == utf8.RuneError && size == 1 {
+			return &Int{Value: int64(i)}, nil
+		}
+		i += size
+	}
+
+	return &Int{Value: -1}, nil
+}
@@ -0,0 +1,39 @@
+package objects
+
+import (
+	"fmt"
+	"time"
+)
+
+// with_timeout(fn, ns) calls fn and aborts it if it does not return
+// within the given nanoseconds. It returns an error object if fn is aborted
+// or the timeout is not positive.
+func builtinWithTimeout(rt Interop, args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	if !isCallable(args[0]) {
+		return nil, fmt.Errorf("unsupported type for 'with_timeout' function: %s", args[0].TypeName())
+	}
+
+	ns, ok := args[1].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'with_timeout' function: %s", args[1].TypeName())
+	}
+
+	if ns.Value <= 0 {
+		return &Error{Value: &String{Value: fmt.Sprintf("invalid timeout: %d", ns.Value)}}, nil
+	}
+
+	timeout := time.Duration(ns.Value)
+
+	res, err := rt.CallTimeout(args[0], timeout)
+	if err == ErrTimeout {
+		return &Error{Value: &String{Value: fmt.Sprintf("timeout after %s", timeout)}}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,59 @@
+package objects
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// word_wrap(s, width) breaks the lines of s at the spaces so that each line
+// fits in width characters. The existing newlines are preserved, and the
+// words longer than width are placed on their own lines without breaking.
+func builtinWordWrap(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	s, ok := args[0].(*String)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'word_wrap' function: %s", args[0].TypeName())
+	}
+
+	width, ok := args[1].(*Int)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'word_wrap' function: %s", args[1].TypeName())
+	}
+
+	if width.Value <= 0 {
+		return &Error{Value: &String{Value: fmt.Sprintf("invalid width: %d", width.Value)}}, nil
+	}
+
+	paragraphs := strings.Split(s.Value, "\n")
+	for i, p := range paragraphs {
+		paragraphs[i] = wrapLine(p, int(width.Value))
+	}
+
+	return &String{Value: strings.Join(paragraphs, "\n")}, nil
+}
+
+func wrapLine(line string, width int) string {
+	var sb strings.Builder
+	var n int // characters in the current line
+	for _, word := range strings.Fields(line) {
+		w := utf8.RuneCountInString(word)
+		if n > 0 {
+			if n+1+w > width {
+				sb.WriteByte('\n')
+				n = 0
+			} else {
+				sb.WriteByte(' ')
+				n++
+			}
+		}
+
+		sb.WriteString(word)
+		n += w
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,42 @@
+package objects
+
+import (
+	"fmt"
+)
+
+// zip_map(keys, values) creates a map of the keys and the values at the same
+// indexes. The keys are converted to strings, and the extra elements of the
+// longer array are ignored.
+func builtinZipMap(args ...Object) (Object, error) {
+	if len(args) != 2 {
+		return nil, ErrWrongNumArguments
+	}
+
+	keys, ok := arrayElements(args[0])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'zip_map' function: %s", args[0].TypeName())
+	}
+
+	values, ok := arrayElements(args[1])
+	if !ok {
+		return nil, fmt.Errorf("unsupported type for 'zip_map' function: %s", args[1].TypeName())
+	}
+
+	n := len(keys)
+	if len(values) < n {
+		n = len(values)
+	}
+
+	m := make(map[string]Object, n)
+	for i := 0; i < n; i++ {
+		switch keys[i].(type) {
+		case *String, *Int, *Float, *Char, *Bool, *Bytes, *Time:
+		default:
+			return nil, fmt.Errorf("invalid key for 'zip_map' function: %s", keys[i].TypeName())
+		}
+
+		m[toStringValue(keys[i]).(*String).Value] = values[i]
+	}
+
+	return &Map{Value: m}, nil
+}
@@ -1,20 +1,24 @@
 package objects
 
 // NamedBuiltinFunc is a named builtin function.
+// InteropFunc is used for the functions that need the access to the runtime.
 type NamedBuiltinFunc struct {
-	Name string
-	Func CallableFunc
+	Name        string
+	Func        CallableFunc
+	InteropFunc InteropFunc
 }
 
-// Builtins contains all default builtin functions.
+// Builtins contains all default builtin functions. New functions must be
+// appended at the end, because compiled bytecode refers to the builtin
+// functions by their indices.
 var Builtins = []NamedBuiltinFunc{
 	{
-		Name: "print",
-		Func: builtinPrint,
+		Name:        "print",
+		InteropFunc: builtinPrint,
 	},
 	{
-		Name: "printf",
-		Func: builtinPrintf,
+		Name:        "printf",
+		InteropFunc: builtinPrintf,
 	},
 	{
 		Name: "sprintf",
@@ -124,4 +128,436 @@ var Builtins = []NamedBuiltinFunc{
 		Name: "type_name",
 		Func: builtinTypeName,
 	},
+	{
+		Name: "set",
+		Func: builtinSet,
+	},
+	{
+		Name: "is_set",
+		Func: builtinIsSet,
+	},
+	{
+		Name: "delete",
+		Func: builtinDelete,
+	},
+	{
+		Name: "ordered_map",
+		Func: builtinOrderedMap,
+	},
+	{
+		Name: "channel",
+		Func: builtinChannel,
+	},
+	{
+		Name:        "go",
+		InteropFunc: builtinGo,
+	},
+	{
+		Name: "add_checked",
+		Func: builtinAddChecked,
+	},
+	{
+		Name: "sub_checked",
+		Func: builtinSubChecked,
+	},
+	{
+		Name: "mul_checked",
+		Func: builtinMulChecked,
+	},
+	{
+		Name: "div_checked",
+		Func: builtinDivChecked,
+	},
+	{
+		Name: "bit_set",
+		Func: builtinBitSet,
+	},
+	{
+		Name: "bit_clear",
+		Func: builtinBitClear,
+	},
+	{
+		Name: "bit_test",
+		Func: builtinBitTest,
+	},
+	{
+		Name: "popcount",
+		Func: builtinPopcount,
+	},
+	{
+		Name: "leading_zeros",
+		Func: builtinLeadingZeros,
+	},
+	{
+		Name: "trailing_zeros",
+		Func: builtinTrailingZeros,
+	},
+	{
+		Name: "int_to_bytes",
+		Func: builtinIntToBytes,
+	},
+	{
+		Name: "bytes_to_int",
+		Func: builtinBytesToInt,
+	},
+	{
+		Name: "now",
+		Func: builtinNow,
+	},
+	{
+		Name: "unix_now",
+		Func: builtinUnixNow,
+	},
+	{
+		Name: "unix_nano",
+		Func: builtinUnixNano,
+	},
+	{
+		Name: "since",
+		Func: builtinSince,
+	},
+	{
+		Name:        "sleep",
+		InteropFunc: builtinSleep,
+	},
+	{
+		Name:        "group_by",
+		InteropFunc: builtinGroupBy,
+	},
+	{
+		Name:        "partition",
+		InteropFunc: builtinPartition,
+	},
+	{
+		Name: "unique",
+		Func: builtinUnique,
+	},
+	{
+		Name: "chunk",
+		Func: builtinChunk,
+	},
+	{
+		Name: "take",
+		Func: builtinTake,
+	},
+	{
+		Name: "drop",
+		Func: builtinDrop,
+	},
+	{
+		Name:        "find_index",
+		InteropFunc: builtinFindIndex,
+	},
+	{
+		Name:        "find_last_index",
+		InteropFunc: builtinFindLastIndex,
+	},
+	{
+		Name: "count",
+		Func: builtinCount,
+	},
+	{
+		Name:        "count_by",
+		InteropFunc: builtinCountBy,
+	},
+	{
+		Name: "entries",
+		Func: builtinEntries,
+	},
+	{
+		Name: "from_entries",
+		Func: builtinFromEntries,
+	},
+	{
+		Name: "pick",
+		Func: builtinPick,
+	},
+	{
+		Name: "omit",
+		Func: builtinOmit,
+	},
+	{
+		Name: "format_float",
+		Func: builtinFormatFloat,
+	},
+	{
+		Name: "nan",
+		Func: builtinNaN,
+	},
+	{
+		Name: "inf",
+		Func: builtinInf,
+	},
+	{
+		Name: "is_nan",
+		Func: builtinIsNaN,
+	},
+	{
+		Name: "is_inf",
+		Func: builtinIsInf,
+	},
+	{
+		Name: "is_finite",
+		Func: builtinIsFinite,
+	},
+	{
+		Name: "round_to",
+		Func: builtinRoundTo,
+	},
+	{
+		Name: "expand",
+		Func: builtinExpand,
+	},
+	{
+		Name:        "times",
+		InteropFunc: builtinTimes,
+	},
+	{
+		Name: "get",
+		Func: builtinGet,
+	},
+	{
+		Name: "set_in",
+		Func: builtinSetIn,
+	},
+	{
+		Name: "get_in",
+		Func: builtinGetIn,
+	},
+	{
+		Name: "to_camel",
+		Func: builtinToCamel,
+	},
+	{
+		Name: "to_snake",
+		Func: builtinToSnake,
+	},
+	{
+		Name: "to_kebab",
+		Func: builtinToKebab,
+	},
+	{
+		Name: "pad_number",
+		Func: builtinPadNumber,
+	},
+	{
+		Name:        "sorted",
+		InteropFunc: builtinSorted,
+	},
+	{
+		Name: "to_char",
+		Func: builtinToChar,
+	},
+	{
+		Name: "to_upper",
+		Func: builtinToUpper,
+	},
+	{
+		Name: "to_lower",
+		Func: builtinToLower,
+	},
+	{
+		Name: "fold",
+		Func: builtinFold,
+	},
+	{
+		Name: "strip_bom",
+		Func: builtinStripBOM,
+	},
+	{
+		Name: "is_valid_utf8",
+		Func: builtinIsValidUTF8,
+	},
+	{
+		Name: "utf8_error_at",
+		Func: builtinUTF8ErrorAt,
+	},
+	{
+		Name: "edit_distance",
+		Func: builtinEditDistance,
+	},
+	{
+		Name: "word_wrap",
+		Func: builtinWordWrap,
+	},
+	{
+		Name: "indent",
+		Func: builtinIndent,
+	},
+	{
+		Name: "dedent",
+		Func: builtinDedent,
+	},
+	{
+		Name: "lines",
+		Func: builtinLines,
+	},
+	{
+		Name: "unlines",
+		Func: builtinUnlines,
+	},
+	{
+		Name: "parse_duration",
+		Func: builtinParseDuration,
+	},
+	{
+		Name: "format_duration",
+		Func: builtinFormatDuration,
+	},
+	{
+		Name: "is_empty",
+		Func: builtinIsEmpty,
+	},
+	{
+		Name:        "dump",
+		InteropFunc: builtinDump,
+	},
+	{
+		Name: "assert",
+		Func: builtinAssert,
+	},
+	{
+		Name: "wrap_error",
+		Func: builtinWrapError,
+	},
+	{
+		Name: "error_cause",
+		Func: builtinErrorCause,
+	},
+	{
+		Name: "error_with",
+		Func: builtinErrorWith,
+	},
+	{
+		Name: "error_fields",
+		Func: builtinErrorFields,
+	},
+	{
+		Name: "error_kind",
+		Func: builtinErrorKind,
+	},
+	{
+		Name:        "eval",
+		InteropFunc: builtinEval,
+	},
+	{
+		Name: "parse",
+		Func: builtinParse,
+	},
+	{
+		Name: "range",
+		Func: builtinRange,
+	},
+	{
+		Name:        "lazy_map",
+		InteropFunc: builtinLazyMap,
+	},
+	{
+		Name:        "lazy_filter",
+		InteropFunc: builtinLazyFilter,
+	},
+	{
+		Name:        "collect",
+		InteropFunc: builtinCollect,
+	},
+	{
+		Name:        "timeit",
+		InteropFunc: builtinTimeit,
+	},
+	{
+		Name: "builder",
+		Func: builtinBuilder,
+	},
+	{
+		Name: "extend",
+		Func: builtinExtend,
+	},
+	{
+		Name: "readonly",
+		Func: builtinReadOnly,
+	},
+	{
+		Name: "hash",
+		Func: builtinHash,
+	},
+	{
+		Name: "is_subset",
+		Func: builtinIsSubset,
+	},
+	{
+		Name: "diff",
+		Func: builtinDiff,
+	},
+	{
+		Name: "dict",
+		Func: builtinDict,
+	},
+	{
+		Name:        "call_method",
+		InteropFunc: builtinCallMethod,
+	},
+	{
+		Name: "same",
+		Func: builtinSame,
+	},
+	{
+		Name:        "const_map",
+		InteropFunc: builtinConstMap,
+	},
+	{
+		Name:        "const_array",
+		InteropFunc: builtinConstArray,
+	},
+	{
+		Name: "flatten_map",
+		Func: builtinFlattenMap,
+	},
+	{
+		Name: "unflatten_map",
+		Func: builtinUnflattenMap,
+	},
+	{
+		Name: "to_string_map",
+		Func: builtinToStringMap,
+	},
+	{
+		Name: "zip_map",
+		Func: builtinZipMap,
+	},
+	{
+		Name:        "retry",
+		InteropFunc: builtinRetry,
+	},
+	{
+		Name:        "with_timeout",
+		InteropFunc: builtinWithTimeout,
+	},
+	{
+		Name: "compose",
+		Func: builtinCompose,
+	},
+	{
+		Name: "pipe",
+		Func: builtinPipe,
+	},
+	{
+		Name: "partial",
+		Func: builtinPartial,
+	},
+	{
+		Name: "curry",
+		Func: builtinCurry,
+	},
+	{
+		Name: "collect_errors",
+		Func: builtinCollectErrors,
+	},
+	{
+		Name: "encode_query",
+		Func: builtinEncodeQuery,
+	},
+	{
+		Name: "decode_query",
+		Func: builtinDecodeQuery,
+	},
 }
@@ -0,0 +1,51 @@
+package objects_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/assert"
+	"github.com/d5/tengo/objects"
+)
+
+func TestBuiltins_Indices(t *testing.T) {
+	// compiled bytecode refers to the builtin functions by their indices,
+	// so the existing functions must not be moved.
+	names := []string{
+		"print", "printf", "sprintf", "len", "copy", "append", "string",
+		"int", "bool", "float", "char", "bytes", "time", "is_int",
+		"is_float", "is_string", "is_bool", "is_char", "is_bytes",
+		"is_array", "is_immutable_array", "is_map", "is_immutable_map",
+		"is_time", "is_error", "is_undefined", "to_json", "from_json",
+		"type_name", "set", "is_set", "delete",
+	}
+	for idx, name := range names {
+		assert.Equal(t, name, objects.Builtins[idx].Name)
+	}
+}
+
+func TestBuiltins_FloatBool(t *testing.T) {
+	// decoded bools are not the same objects as TrueValue and FalseValue
+	decodedTrue, decodedFalse := &objects.Bool{}, &objects.Bool{}
+	assert.NoError(t, decodedTrue.GobDecode([]byte{1}))
+	assert.NoError(t, decodedFalse.GobDecode([]byte{0}))
+
+	float := builtin(t, "float")
+	res, err := float.Func(decodedTrue)
+	assert.NoError(t, err)
+	assert.Equal(t, &objects.Float{Value: 1}, res)
+	res, err = float.Func(decodedFalse)
+	assert.NoError(t, err)
+	assert.Equal(t, &objects.Float{Value: 0}, res)
+}
+
+func builtin(t *testing.T, name string) objects.NamedBuiltinFunc {
+	for _, fn := range objects.Builtins {
+		if fn.Name == name {
+			return fn
+		}
+	}
+
+	t.Fatalf("builtin function not found: %s", name)
+
+	return objects.NamedBuiltinFunc{}
+}
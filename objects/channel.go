@@ -0,0 +1,255 @@
+package objects
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+
+	"github.com/d5/tengo/compiler/token"
+)
+
+// ErrClosedChannel is an error where a closed channel is used to send a value
+// or closed again.
+var ErrClosedChannel = errors.New("channel is closed")
+
+// Channel represents a channel of objects. Blocking operations of the
+// channel return when the VM execution is aborted. Closing the channel does
+// not close Value, so the pending sends never panic.
+type Channel struct {
+	Value     chan Object
+	closeLock sync.Mutex
+	closed    chan struct{}
+}
+
+// NewChannel creates a channel with the given buffer size.
+func NewChannel(size int) *Channel {
+	return &Channel{
+		Value:  make(chan Object, size),
+		closed: make(chan struct{}),
+	}
+}
+
+// TypeName returns the name of the type.
+func (o *Channel) TypeName() string {
+	return "channel"
+}
+
+func (o *Channel) String() string {
+	return "<channel>"
+}
+
+// BinaryOp returns another object that is the result of
+// a given binary operator and a right-hand side object.
+func (o *Channel) BinaryOp(op token.Token, rhs Object) (Object, error) {
+	return nil, ErrInvalidOperator
+}
+
+// Copy returns a copy of the type. The copy shares the same underlying channel.
+func (o *Channel) Copy() Object {
+	return o
+}
+
+// IsFalsy returns true if the value of the type is falsy.
+func (o *Channel) IsFalsy() bool {
+	return false
+}
+
+// Equals returns true if the value of the type
+// is equal to the value of another object.
+func (o *Channel) Equals(x Object) bool {
+	return o == x
+}
+
+// Send sends the value to the channel. It returns false
+// without sending the value if done is closed first. The pending
+// send fails when the channel is closed.
+func (o *Channel) Send(value Object, done <-chan struct{}) (sent bool, err error) {
+	if o.isClosed() {
+		return false, ErrClosedChannel
+	}
+
+	select {
+	case o.Value <- value:
+		return true, nil
+	case <-o.closed:
+		return false, ErrClosedChannel
+	case <-done:
+		return false, nil
+	}
+}
+
+// Receive receives a value from the channel. It returns undefined if
+// the channel is closed and empty or if done is closed first.
+func (o *Channel) Receive(done <-chan struct{}) Object {
+	select {
+	case v := <-o.Value:
+		return v
+	case <-o.closed:
+		return o.drain()
+	case <-done:
+		return UndefinedValue
+	}
+}
+
+// Close closes the channel. It does not wait for the pending sends.
+func (o *Channel) Close() error {
+	o.closeLock.Lock()
+	defer o.closeLock.Unlock()
+
+	if o.isClosed() {
+		return ErrClosedChannel
+	}
+
+	close(o.closed)
+
+	return nil
+}
+
+func (o *Channel) isClosed() bool {
+	select {
+	case <-o.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// drain returns a value buffered in the closed channel
+// or undefined if there is none.
+func (o *Channel) drain() Object {
+	select {
+	case v := <-o.Value:
+		return v
+	default:
+		return UndefinedValue
+	}
+}
+
+// IndexGet returns the channel method for the given name.
+func (o *Channel) IndexGet(index Object) (res Object, err error) {
+	strIdx, ok := index.(*String)
+	if !ok {
+		err = ErrInvalidIndexType
+		return
+	}
+
+	switch strIdx.Value {
+	case "send":
+		res = &InteropFunction{Value: func(rt Interop, args ...Object) (Object, error) {
+			if len(args) != 1 {
+				return nil, ErrWrongNumArguments
+			}
+
+			if _, err := o.Send(ForkCopy(args[0]), rt.Done()); err != nil {
+				return nil, err
+			}
+
+			return UndefinedValue, nil
+		}}
+	case "receive":
+		res = &InteropFunction{Value: func(rt Interop, args ...Object) (Object, error) {
+			if len(args) != 0 {
+				return nil, ErrWrongNumArguments
+			}
+
+			return o.Receive(rt.Done()), nil
+		}}
+	case "close":
+		res = &UserFunction{Value: func(args ...Object) (Object, error) {
+			if len(args) != 0 {
+				return nil, ErrWrongNumArguments
+			}
+
+			if err := o.Close(); err != nil {
+				return nil, err
+			}
+
+			return UndefinedValue, nil
+		}}
+	case "len":
+		res = &UserFunction{Value: func(args ...Object) (Object, error) {
+			if len(args) != 0 {
+				return nil, ErrWrongNumArguments
+			}
+
+			return &Int{Value: int64(len(o.Value))}, nil
+		}}
+	default:
+		res = UndefinedValue
+	}
+
+	return
+}
+
+// SelectCase represents a case of the select statement.
+type SelectCase struct {
+	Channel *Channel
+	Send    bool
+	Value   Object // value to send
+}
+
+// Select waits until one of the cases can proceed and returns the index of
+// the chosen case and the received value (undefined for the send cases).
+// If block is false and no case is ready or if done is closed first,
+// it returns -1.
+func Select(cases []SelectCase, block bool, done <-chan struct{}) (chosen int, received Object, err error) {
+	// each case is followed by the closed signal of its channel
+	selectCases := make([]reflect.SelectCase, 0, len(cases)*2+1)
+	for _, c := range cases {
+		if c.Send {
+			if c.Channel.isClosed() {
+				return -1, nil, ErrClosedChannel
+			}
+
+			selectCases = append(selectCases, reflect.SelectCase{
+				Dir:  reflect.SelectSend,
+				Chan: reflect.ValueOf(c.Channel.Value),
+				Send: reflect.ValueOf(&c.Value).Elem(),
+			})
+		} else {
+			selectCases = append(selectCases, reflect.SelectCase{
+				Dir:  reflect.SelectRecv,
+				Chan: reflect.ValueOf(c.Channel.Value),
+			})
+		}
+
+		selectCases = append(selectCases, reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(c.Channel.closed),
+		})
+	}
+
+	if block {
+		// nil done channel is never ready
+		selectCases = append(selectCases, reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(done),
+		})
+	} else {
+		selectCases = append(selectCases, reflect.SelectCase{
+			Dir: reflect.SelectDefault,
+		})
+	}
+
+	idx, recv, _ := reflect.Select(selectCases)
+	if idx == len(cases)*2 {
+		return -1, UndefinedValue, nil
+	}
+
+	chosen = idx / 2
+	c := cases[chosen]
+	if idx%2 == 1 {
+		// the channel of the case is closed
+		if c.Send {
+			return -1, nil, ErrClosedChannel
+		}
+
+		return chosen, c.Channel.drain(), nil
+	}
+
+	if c.Send {
+		return chosen, UndefinedValue, nil
+	}
+
+	return chosen, recv.Interface().(Object), nil
+}
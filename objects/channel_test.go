@@ -0,0 +1,40 @@
+package objects_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/d5/tengo/assert"
+	"github.com/d5/tengo/objects"
+)
+
+func TestChannel_Close(t *testing.T) {
+	ch := objects.NewChannel(0)
+
+	// closing the channel does not wait for the blocked sender
+	sendErr := make(chan error)
+	go func() {
+		_, err := ch.Send(&objects.Int{Value: 1}, nil)
+		sendErr <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	assert.NoError(t, ch.Close())
+	assert.Equal(t, objects.ErrClosedChannel, <-sendErr)
+
+	_, err := ch.Send(&objects.Int{Value: 2}, nil)
+	assert.Equal(t, objects.ErrClosedChannel, err)
+	assert.Equal(t, objects.UndefinedValue, ch.Receive(nil))
+	assert.Equal(t, objects.ErrClosedChannel, ch.Close())
+
+	// buffered values are received after the channel is closed
+	ch = objects.NewChannel(2)
+	_, _ = ch.Send(&objects.Int{Value: 1}, nil)
+	assert.NoError(t, ch.Close())
+	assert.Equal(t, &objects.Int{Value: 1}, ch.Receive(nil))
+	assert.Equal(t, objects.UndefinedValue, ch.Receive(nil))
+
+	chosen, received, err := objects.Select([]objects.SelectCase{{Channel: ch}}, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, chosen)
+	assert.Equal(t, objects.UndefinedValue, received)
+}
@@ -17,6 +17,8 @@ func ToString(o Object) (v string, ok bool) {
 
 	if str, isStr := o.(*String); isStr {
 		v = str.Value
+	} else if sb, isBuilder := o.(*StringBuilder); isBuilder {
+		v = sb.Value.String()
 	} else {
 		v = o.String()
 	}
@@ -156,6 +158,32 @@ func ToTime(o Object) (v time.Time, ok bool) {
 	return
 }
 
+// arrayElements returns the elements of an array or an immutable array.
+func arrayElements(o Object) ([]Object, bool) {
+	switch o := o.(type) {
+	case *Array:
+		return o.Value, true
+	case *ImmutableArray:
+		return o.Value, true
+	}
+
+	return nil, false
+}
+
+// mapElements returns the elements of a map or an immutable map.
+func mapElements(o Object) (map[string]Object, bool) {
+	switch o := o.(type) {
+	case *Map:
+		return o.Value, true
+	case *ImmutableMap:
+		return o.Value, true
+	case *ReadOnlyMap:
+		return o.Value.Value, true
+	}
+
+	return nil, false
+}
+
 // objectToInterface attempts to convert an object o to an interface{} value
 func objectToInterface(o Object) (res interface{}) {
 	switch o := o.(type) {
@@ -6,9 +6,12 @@ import (
 	"github.com/d5/tengo/compiler/token"
 )
 
-// Error represents a string value.
+// Error represents an error value.
 type Error struct {
-	Value Object
+	Value  Object
+	Kind   string            // the category of the error, or empty
+	Cause  Object            // the wrapped error, or nil
+	Fields map[string]Object // additional context, or nil
 }
 
 // TypeName returns the name of the type.
@@ -37,7 +40,19 @@ func (o *Error) IsFalsy() bool {
 
 // Copy returns a copy of the type.
 func (o *Error) Copy() Object {
-	return &Error{Value: o.Value.Copy()}
+	c := &Error{Value: o.Value.Copy(), Kind: o.Kind}
+	if o.Cause != nil {
+		c.Cause = o.Cause.Copy()
+	}
+
+	if o.Fields != nil {
+		c.Fields = make(map[string]Object, len(o.Fields))
+		for k, v := range o.Fields {
+			c.Fields[k] = v.Copy()
+		}
+	}
+
+	return c
 }
 
 // Equals returns true if the value of the type
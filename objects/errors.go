@@ -22,3 +22,14 @@ var ErrWrongNumArguments = errors.New("wrong number of arguments")
 
 // ErrInvalidTypeConversion  represents an invalid type conversion error.
 var ErrInvalidTypeConversion = errors.New("invalid type conversion")
+
+// ErrTimeout means the function call did not return within the timeout.
+var ErrTimeout = errors.New("timeout")
+
+// ErrAborted means the function call returned because the execution
+// was aborted.
+var ErrAborted = errors.New("execution aborted")
+
+// ErrForkedIterator means a lazy iterator was read in another goroutine
+// than the one that created it.
+var ErrForkedIterator = errors.New("iterator cannot be used in another goroutine")
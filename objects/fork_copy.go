@@ -0,0 +1,71 @@
+package objects
+
+// ForkCopy returns a copy of the object that can be used in another
+// goroutine. Unlike Copy, it also copies the free variables of a closure
+// and keeps the immutable containers immutable. Compiled functions are not
+// copied because they are never modified. A read-only map view is not
+// copied either, so the goroutines can share a map without copying it.
+// A lazy iterator cannot be shared
+// because its elements are produced on demand, so it is replaced with an
+// iterator that fails with ErrForkedIterator when it's read.
+func ForkCopy(o Object) Object {
+	switch o := o.(type) {
+	case *CompiledFunction, *ReadOnlyMap:
+		return o
+	case *Closure:
+		free := make([]*Object, len(o.Free))
+		for i, v := range o.Free {
+			c := ForkCopy(*v)
+			free[i] = &c
+		}
+
+		return &Closure{Fn: o.Fn, Free: free}
+	case *LazyIterator:
+		return NewLazyIterator(nil, func(Interop) (Object, Object, bool, error) {
+			return nil, nil, false, ErrForkedIterator
+		})
+	case *Array:
+		return &Array{Value: forkCopyArray(o.Value)}
+	case *ImmutableArray:
+		return &ImmutableArray{Value: forkCopyArray(o.Value)}
+	case *Map:
+		return &Map{Value: forkCopyMap(o.Value)}
+	case *ImmutableMap:
+		return &ImmutableMap{Value: forkCopyMap(o.Value)}
+	case *OrderedMap:
+		return &OrderedMap{
+			keys:  append([]string(nil), o.keys...),
+			value: forkCopyMap(o.value),
+		}
+	case *Error:
+		c := &Error{Value: ForkCopy(o.Value), Kind: o.Kind}
+		if o.Cause != nil {
+			c.Cause = ForkCopy(o.Cause)
+		}
+		if o.Fields != nil {
+			c.Fields = forkCopyMap(o.Fields)
+		}
+
+		return c
+	default:
+		return o.Copy()
+	}
+}
+
+func forkCopyArray(a []Object) []Object {
+	c := make([]Object, len(a))
+	for i, v := range a {
+		c[i] = ForkCopy(v)
+	}
+
+	return c
+}
+
+func forkCopyMap(m map[string]Object) map[string]Object {
+	c := make(map[string]Object, len(m))
+	for k, v := range m {
+		c[k] = ForkCopy(v)
+	}
+
+	return c
+}
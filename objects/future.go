@@ -0,0 +1,109 @@
+package objects
+
+import (
+	"github.com/d5/tengo/compiler/token"
+)
+
+// Future represents the result of a function running concurrently.
+type Future struct {
+	done chan struct{}
+	ret  Object
+	err  error
+}
+
+// NewFuture calls fn in a new goroutine using the forked runtime
+// and returns a Future for its result. The function and the arguments
+// are copied using ForkCopy.
+func NewFuture(rt Interop, fn Object, args ...Object) *Future {
+	f := &Future{done: make(chan struct{})}
+	forked := rt.Fork()
+
+	fn = ForkCopy(fn)
+	forkedArgs := make([]Object, len(args))
+	for i, arg := range args {
+		forkedArgs[i] = ForkCopy(arg)
+	}
+
+	go func() {
+		defer close(f.done)
+
+		f.ret, f.err = forked.Call(fn, forkedArgs...)
+	}()
+
+	return f
+}
+
+// TypeName returns the name of the type.
+func (o *Future) TypeName() string {
+	return "future"
+}
+
+func (o *Future) String() string {
+	return "<future>"
+}
+
+// BinaryOp returns another object that is the result of
+// a given binary operator and a right-hand side object.
+func (o *Future) BinaryOp(op token.Token, rhs Object) (Object, error) {
+	return nil, ErrInvalidOperator
+}
+
+// Copy returns a copy of the type. The copy waits for the same result.
+func (o *Future) Copy() Object {
+	return o
+}
+
+// IsFalsy returns true if the value of the type is falsy.
+func (o *Future) IsFalsy() bool {
+	return false
+}
+
+// Equals returns true if the value of the type
+// is equal to the value of another object.
+func (o *Future) Equals(x Object) bool {
+	return o == x
+}
+
+// Await waits for the function to return and returns its result.
+// If done is closed first, it returns undefined.
+func (o *Future) Await(done <-chan struct{}) (Object, error) {
+	select {
+	case <-o.done:
+	case <-done:
+		return UndefinedValue, nil
+	}
+
+	if o.err != nil {
+		return nil, o.err
+	}
+
+	if o.ret == nil {
+		return UndefinedValue, nil
+	}
+
+	return o.ret, nil
+}
+
+// IndexGet returns the future method for the given name.
+func (o *Future) IndexGet(index Object) (res Object, err error) {
+	strIdx, ok := index.(*String)
+	if !ok {
+		err = ErrInvalidIndexType
+		return
+	}
+
+	switch strIdx.Value {
+	case "await":
+		res = &InteropFunction{Value: func(rt Interop, args ...Object) (Object, error) {
+			if len(args) != 0 {
+				return nil, ErrWrongNumArguments
+			}
+
+			return o.Await(rt.Done())
+		}}
+	default:
+		res = UndefinedValue
+	}
+
+	return
+}
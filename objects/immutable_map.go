@@ -72,6 +72,8 @@ func (o *ImmutableMap) Equals(x Object) bool {
 		xVal = x.Value
 	case *ImmutableMap:
 		xVal = x.Value
+	case *ReadOnlyMap:
+		xVal = x.Value.Value
 	default:
 		return false
 	}
@@ -0,0 +1,94 @@
+package objects
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Interop represents the runtime that calls an InteropCallable.
+// It allows the functions to interact with the running VM.
+type Interop interface {
+	// Done returns a channel that is closed when the execution is aborted
+	// (e.g. the context of Script.RunContext is canceled). Functions that
+	// block should return when the channel is closed.
+	Done() <-chan struct{}
+
+	// Call calls a callable object, including compiled functions
+	// and closures, with the arguments. If the execution is aborted,
+	// it returns ErrAborted.
+	Call(fn Object, args ...Object) (ret Object, err error)
+
+	// CallTimeout calls the function like Call but aborts the call if it
+	// does not return within the timeout and returns ErrTimeout then.
+	CallTimeout(fn Object, timeout time.Duration, args ...Object) (ret Object, err error)
+
+	// Fork returns an Interop that can call the functions
+	// concurrently (e.g. in another goroutine). The forked Interop
+	// is aborted when the execution ends.
+	Fork() Interop
+
+	// Output returns the writer that the output functions
+	// (e.g. print) write to.
+	Output() io.Writer
+
+	// Eval compiles and runs the source in a new VM that cannot access
+	// the global variables of the caller and returns the value of the
+	// last expression statement. If the source cannot be compiled,
+	// it returns an error object.
+	Eval(src string) (ret Object, err error)
+}
+
+// InteropCallable represents an object that can be called like a function
+// with the access to the runtime. The VM prefers CallInterop over Call if
+// the object implements both.
+type InteropCallable interface {
+	CallInterop(rt Interop, args ...Object) (ret Object, err error)
+}
+
+// InteropFunc is a function signature for the functions
+// that need the access to the runtime.
+type InteropFunc func(rt Interop, args ...Object) (ret Object, err error)
+
+// noInterop is used when an InteropCallable is called outside of the VM.
+type noInterop struct{}
+
+// Done returns nil channel that is never closed.
+func (noInterop) Done() <-chan struct{} {
+	return nil
+}
+
+// Call calls the function. Compiled functions cannot be called without the VM.
+func (rt noInterop) Call(fn Object, args ...Object) (Object, error) {
+	switch fn := fn.(type) {
+	case InteropCallable:
+		return fn.CallInterop(rt, args...)
+	case Callable:
+		return fn.Call(args...)
+	}
+
+	return nil, fmt.Errorf("cannot call %s outside of the VM", fn.TypeName())
+}
+
+// CallTimeout calls the function without the timeout. The functions
+// cannot be aborted outside of the VM.
+func (rt noInterop) CallTimeout(fn Object, timeout time.Duration, args ...Object) (Object, error) {
+	return rt.Call(fn, args...)
+}
+
+// Fork returns the same noInterop.
+func (rt noInterop) Fork() Interop {
+	return rt
+}
+
+// Output returns the standard output.
+func (noInterop) Output() io.Writer {
+	return os.Stdout
+}
+
+// Eval returns an error. The source cannot be compiled outside of the VM.
+func (noInterop) Eval(src string) (Object, error) {
+	return nil, errors.New("cannot evaluate the source outside of the VM")
+}
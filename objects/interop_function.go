@@ -0,0 +1,52 @@
+package objects
+
+import (
+	"github.com/d5/tengo/compiler/token"
+)
+
+// InteropFunction represents a function that has the access to the runtime.
+type InteropFunction struct {
+	Value InteropFunc
+}
+
+// TypeName returns the name of the type.
+func (o *InteropFunction) TypeName() string {
+	return "user-function"
+}
+
+func (o *InteropFunction) String() string {
+	return "<user-function>"
+}
+
+// BinaryOp returns another object that is the result of
+// a given binary operator and a right-hand side object.
+func (o *InteropFunction) BinaryOp(op token.Token, rhs Object) (Object, error) {
+	return nil, ErrInvalidOperator
+}
+
+// Copy returns a copy of the type.
+func (o *InteropFunction) Copy() Object {
+	return &InteropFunction{Value: o.Value}
+}
+
+// IsFalsy returns true if the value of the type is falsy.
+func (o *InteropFunction) IsFalsy() bool {
+	return false
+}
+
+// Equals returns true if the value of the type
+// is equal to the value of another object.
+func (o *InteropFunction) Equals(x Object) bool {
+	return false
+}
+
+// Call invokes the function without the runtime.
+// Blocking operations of the function cannot be canceled.
+func (o *InteropFunction) Call(args ...Object) (Object, error) {
+	return o.Value(noInterop{}, args...)
+}
+
+// CallInterop invokes the function with the runtime.
+func (o *InteropFunction) CallInterop(rt Interop, args ...Object) (Object, error) {
+	return o.Value(rt, args...)
+}
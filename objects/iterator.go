@@ -13,3 +13,41 @@ type Iterator interface {
 	// Value returns the value of the current element.
 	Value() Object
 }
+
+// ErrorIterator represents an iterator that can fail while producing
+// the elements. When Next returns false, Err returns the error that
+// stopped the iteration or nil if there are no more elements.
+type ErrorIterator interface {
+	Iterator
+
+	// Err returns the error that stopped the iteration.
+	Err() error
+}
+
+// InteropIterator represents an iterator that calls functions to produce
+// the elements. The VM calls NextInterop instead of Next so that the
+// functions are called using the runtime that reads the iterator.
+type InteropIterator interface {
+	Iterator
+
+	// NextInterop is like Next but calls the functions using rt.
+	NextInterop(rt Interop) bool
+}
+
+// iteratorNext advances the iterator using rt if it's an InteropIterator.
+func iteratorNext(rt Interop, it Iterator) bool {
+	if it, ok := it.(InteropIterator); ok {
+		return it.NextInterop(rt)
+	}
+
+	return it.Next()
+}
+
+// iteratorErr returns the error that stopped the iterator, if any.
+func iteratorErr(it Iterator) error {
+	if it, ok := it.(ErrorIterator); ok {
+		return it.Err()
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package objects
+
+import (
+	"github.com/d5/tengo/compiler/token"
+)
+
+// LazyIterator is an iterator that produces the elements on demand
+// using a function. It can be iterated only once.
+type LazyIterator struct {
+	rt    Interop
+	next  func(rt Interop) (key, value Object, ok bool, err error)
+	key   Object
+	value Object
+	done  bool
+	err   error
+}
+
+// NewLazyIterator creates an iterator that calls next to produce each
+// element. next should return false when there are no more elements.
+// next gets the runtime that reads the element (see NextInterop), or rt
+// if the element is read using Next. rt can be nil if next does not call
+// any functions.
+func NewLazyIterator(rt Interop, next func(rt Interop) (key, value Object, ok bool, err error)) *LazyIterator {
+	if rt == nil {
+		rt = noInterop{}
+	}
+
+	return &LazyIterator{rt: rt, next: next}
+}
+
+// TypeName returns the name of the type.
+func (i *LazyIterator) TypeName() string {
+	return "iterator"
+}
+
+func (i *LazyIterator) String() string {
+	return "<iterator>"
+}
+
+// BinaryOp returns another object that is the result of
+// a given binary operator and a right-hand side object.
+func (i *LazyIterator) BinaryOp(op token.Token, rhs Object) (Object, error) {
+	return nil, ErrInvalidOperator
+}
+
+// IsFalsy returns true if the value of the type is falsy.
+func (i *LazyIterator) IsFalsy() bool {
+	return false
+}
+
+// Equals returns true if the value of the type
+// is equal to the value of another object.
+func (i *LazyIterator) Equals(x Object) bool {
+	return i == x
+}
+
+// Copy returns a copy of the type. The copy shares the same iteration state.
+// Use ForkCopy to pass the iterator to another goroutine.
+func (i *LazyIterator) Copy() Object {
+	return i
+}
+
+// Iterate returns the iterator itself.
+func (i *LazyIterator) Iterate() Iterator {
+	return i
+}
+
+// Next returns true if there are more elements to iterate.
+func (i *LazyIterator) Next() bool {
+	return i.NextInterop(i.rt)
+}
+
+// NextInterop is like Next but calls the functions using rt.
+func (i *LazyIterator) NextInterop(rt Interop) bool {
+	if i.done {
+		return false
+	}
+
+	key, value, ok, err := i.next(rt)
+	if err != nil || !ok {
+		i.done = true
+		i.err = err
+		i.key, i.value = nil, nil
+
+		return false
+	}
+
+	i.key, i.value = key, value
+
+	return true
+}
+
+// Key returns the key or index value of the current element.
+func (i *LazyIterator) Key() Object {
+	if i.key == nil {
+		return UndefinedValue
+	}
+
+	return i.key
+}
+
+// Value returns the value of the current element.
+func (i *LazyIterator) Value() Object {
+	if i.value == nil {
+		return UndefinedValue
+	}
+
+	return i.value
+}
+
+// Err returns the error that stopped the iteration.
+func (i *LazyIterator) Err() error {
+	return i.err
+}
+
+// IndexGet returns the iterator method for the given name.
+func (i *LazyIterator) IndexGet(index Object) (res Object, err error) {
+	strIdx, ok := index.(*String)
+	if !ok {
+		err = ErrInvalidIndexType
+		return
+	}
+
+	switch strIdx.Value {
+	case "next":
+		res = &BuiltinFunction{Interop: func(rt Interop, args ...Object) (Object, error) {
+			if len(args) != 0 {
+				return nil, ErrWrongNumArguments
+			}
+
+			if i.NextInterop(rt) {
+				return TrueValue, nil
+			}
+
+			if i.err != nil {
+				return nil, i.err
+			}
+
+			return FalseValue, nil
+		}}
+	case "key":
+		res = &UserFunction{Value: func(args ...Object) (Object, error) {
+			if len(args) != 0 {
+				return nil, ErrWrongNumArguments
+			}
+
+			return i.Key(), nil
+		}}
+	case "value":
+		res = &UserFunction{Value: func(args ...Object) (Object, error) {
+			if len(args) != 0 {
+				return nil, ErrWrongNumArguments
+			}
+
+			return i.Value(), nil
+		}}
+	default:
+		res = UndefinedValue
+	}
+
+	return
+}
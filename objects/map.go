@@ -56,6 +56,8 @@ func (o *Map) Equals(x Object) bool {
 		xVal = x.Value
 	case *ImmutableMap:
 		xVal = x.Value
+	case *ReadOnlyMap:
+		xVal = x.Value.Value
 	default:
 		return false
 	}
@@ -58,5 +58,11 @@ func (i *MapIterator) Key() Object {
 func (i *MapIterator) Value() Object {
 	k := i.k[i.i-1]
 
-	return i.v[k]
+	v, ok := i.v[k]
+	if !ok {
+		// the key was deleted during the iteration
+		return UndefinedValue
+	}
+
+	return v
 }
@@ -24,10 +24,14 @@ func TestObject_TypeName(t *testing.T) {
 	assert.Equal(t, "array", o.TypeName())
 	o = &objects.Map{}
 	assert.Equal(t, "map", o.TypeName())
+	o = &objects.Set{}
+	assert.Equal(t, "set", o.TypeName())
 	o = &objects.ArrayIterator{}
 	assert.Equal(t, "array-iterator", o.TypeName())
 	o = &objects.StringIterator{}
 	assert.Equal(t, "string-iterator", o.TypeName())
+	o = &objects.BytesIterator{}
+	assert.Equal(t, "bytes-iterator", o.TypeName())
 	o = &objects.MapIterator{}
 	assert.Equal(t, "map-iterator", o.TypeName())
 	o = &objects.Break{}
@@ -78,6 +82,8 @@ func TestObject_IsFalsy(t *testing.T) {
 	assert.False(t, o.IsFalsy())
 	o = &objects.StringIterator{}
 	assert.True(t, o.IsFalsy())
+	o = &objects.BytesIterator{}
+	assert.True(t, o.IsFalsy())
 	o = &objects.ArrayIterator{}
 	assert.True(t, o.IsFalsy())
 	o = &objects.MapIterator{}
@@ -132,6 +138,8 @@ func TestObject_String(t *testing.T) {
 	assert.Equal(t, `error: "error 1"`, o.String())
 	o = &objects.StringIterator{}
 	assert.Equal(t, "<string-iterator>", o.String())
+	o = &objects.BytesIterator{}
+	assert.Equal(t, "<bytes-iterator>", o.String())
 	o = &objects.ArrayIterator{}
 	assert.Equal(t, "<array-iterator>", o.String())
 	o = &objects.MapIterator{}
@@ -0,0 +1,201 @@
+package objects
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/d5/tengo/compiler/token"
+)
+
+// OrderedMap represents a map of objects that preserves
+// the insertion order of the keys.
+type OrderedMap struct {
+	keys  []string
+	value map[string]Object
+}
+
+// NewOrderedMap creates an empty ordered map.
+func NewOrderedMap() *OrderedMap {
+	return &OrderedMap{value: make(map[string]Object)}
+}
+
+// Get returns the value for the given key.
+func (o *OrderedMap) Get(key string) (Object, bool) {
+	v, ok := o.value[key]
+
+	return v, ok
+}
+
+// Set sets the value for the given key. A new key is added at the end,
+// and updating an existing key does not change its position.
+func (o *OrderedMap) Set(key string, value Object) {
+	if _, ok := o.value[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+
+	o.value[key] = value
+}
+
+// Delete removes the given key. If the key is added again later,
+// it will be placed at the end.
+func (o *OrderedMap) Delete(key string) {
+	if _, ok := o.value[key]; !ok {
+		return
+	}
+
+	delete(o.value, key)
+
+	for i, k := range o.keys {
+		if k == key {
+			o.keys = append(o.keys[:i], o.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// Keys returns the keys in insertion order.
+func (o *OrderedMap) Keys() []string {
+	return append([]string(nil), o.keys...)
+}
+
+// Len returns the number of the elements.
+func (o *OrderedMap) Len() int {
+	return len(o.keys)
+}
+
+// TypeName returns the name of the type.
+func (o *OrderedMap) TypeName() string {
+	return "ordered-map"
+}
+
+func (o *OrderedMap) String() string {
+	var pairs []string
+	for _, k := range o.keys {
+		pairs = append(pairs, fmt.Sprintf("%s: %s", k, o.value[k].String()))
+	}
+
+	return fmt.Sprintf("{%s}", strings.Join(pairs, ", "))
+}
+
+// BinaryOp returns another object that is the result of
+// a given binary operator and a right-hand side object.
+func (o *OrderedMap) BinaryOp(op token.Token, rhs Object) (Object, error) {
+	return nil, ErrInvalidOperator
+}
+
+// Copy returns a copy of the type.
+func (o *OrderedMap) Copy() Object {
+	c := &OrderedMap{
+		keys:  append([]string(nil), o.keys...),
+		value: make(map[string]Object),
+	}
+	for k, v := range o.value {
+		c.value[k] = v.Copy()
+	}
+
+	return c
+}
+
+// IsFalsy returns true if the value of the type is falsy.
+func (o *OrderedMap) IsFalsy() bool {
+	return len(o.keys) == 0
+}
+
+// Equals returns true if the value of the type
+// is equal to the value of another object.
+// The order of the keys is not considered.
+func (o *OrderedMap) Equals(x Object) bool {
+	var xVal map[string]Object
+	switch x := x.(type) {
+	case *OrderedMap:
+		xVal = x.value
+	case *Map:
+		xVal = x.Value
+	case *ImmutableMap:
+		xVal = x.Value
+	default:
+		return false
+	}
+
+	if len(o.value) != len(xVal) {
+		return false
+	}
+
+	for k, v := range o.value {
+		tv, ok := xVal[k]
+		if !ok || !v.Equals(tv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IndexGet returns the value for the given key.
+func (o *OrderedMap) IndexGet(index Object) (res Object, err error) {
+	strIdx, ok := index.(*String)
+	if !ok {
+		err = ErrInvalidIndexType
+		return
+	}
+
+	val, ok := o.value[strIdx.Value]
+	if !ok {
+		val = UndefinedValue
+	}
+
+	return val, nil
+}
+
+// IndexSet sets the value for the given key.
+func (o *OrderedMap) IndexSet(index, value Object) (err error) {
+	strIdx, ok := ToString(index)
+	if !ok {
+		err = ErrInvalidTypeConversion
+		return
+	}
+
+	o.Set(strIdx, value)
+
+	return nil
+}
+
+// Iterate creates an iterator that yields the elements in insertion order.
+func (o *OrderedMap) Iterate() Iterator {
+	keys := o.Keys()
+
+	return &MapIterator{
+		v: o.value,
+		k: keys,
+		l: len(keys),
+	}
+}
+
+// MarshalJSON encodes the map as a JSON object preserving the key order.
+func (o *OrderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+
+		value, err := json.Marshal(objectToInterface(o.value[k]))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
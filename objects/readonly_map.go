@@ -0,0 +1,57 @@
+package objects
+
+import (
+	"github.com/d5/tengo/compiler/token"
+)
+
+// ReadOnlyMap represents a read-only view of a map. It does not copy
+// the map, so any changes to the underlying map are visible through
+// the view.
+type ReadOnlyMap struct {
+	Value *Map
+}
+
+// TypeName returns the name of the type.
+func (o *ReadOnlyMap) TypeName() string {
+	return "readonly-map"
+}
+
+func (o *ReadOnlyMap) String() string {
+	return o.Value.String()
+}
+
+// BinaryOp returns another object that is the result of
+// a given binary operator and a right-hand side object.
+func (o *ReadOnlyMap) BinaryOp(op token.Token, rhs Object) (Object, error) {
+	return nil, ErrInvalidOperator
+}
+
+// Copy returns a copy of the underlying map.
+func (o *ReadOnlyMap) Copy() Object {
+	return o.Value.Copy()
+}
+
+// IsFalsy returns true if the value of the type is falsy.
+func (o *ReadOnlyMap) IsFalsy() bool {
+	return len(o.Value.Value) == 0
+}
+
+// IndexGet returns the value for the given key.
+func (o *ReadOnlyMap) IndexGet(index Object) (res Object, err error) {
+	return o.Value.IndexGet(index)
+}
+
+// Equals returns true if the value of the type
+// is equal to the value of another object.
+func (o *ReadOnlyMap) Equals(x Object) bool {
+	if t, ok := x.(*ReadOnlyMap); ok {
+		x = t.Value
+	}
+
+	return o.Value.Equals(x)
+}
+
+// Iterate creates a map iterator.
+func (o *ReadOnlyMap) Iterate() Iterator {
+	return o.Value.Iterate()
+}
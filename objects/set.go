@@ -0,0 +1,266 @@
+package objects
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/d5/tengo/compiler/token"
+)
+
+// Set represents a set of hashable objects.
+// Only int, float, string, char, bool, bytes and time values are hashable.
+type Set struct {
+	Value map[interface{}]Object
+}
+
+// bytesKey and timeKey distinguish the hash keys of bytes and time values
+// from the keys of string and int values.
+type bytesKey string
+type timeKey int64
+
+// hashKey returns the hash key of the object.
+func hashKey(o Object) (key interface{}, ok bool) {
+	switch o := o.(type) {
+	case *Int:
+		return o.Value, true
+	case *Float:
+		return o.Value, true
+	case *String:
+		return o.Value, true
+	case *Char:
+		return o.Value, true
+	case *Bool:
+		return o.value, true
+	case *Bytes:
+		return bytesKey(o.Value), true
+	case *Time:
+		return timeKey(o.Value.UnixNano()), true
+	}
+
+	return nil, false
+}
+
+// NewSet creates a set containing the given elements.
+func NewSet(elements ...Object) (*Set, error) {
+	set := &Set{Value: make(map[interface{}]Object)}
+	if err := set.Add(elements...); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// TypeName returns the name of the type.
+func (o *Set) TypeName() string {
+	return "set"
+}
+
+func (o *Set) String() string {
+	var elements []string
+	for _, v := range o.Value {
+		elements = append(elements, v.String())
+	}
+	sort.Strings(elements)
+
+	return fmt.Sprintf("set([%s])", strings.Join(elements, ", "))
+}
+
+// BinaryOp returns another object that is the result of
+// a given binary operator and a right-hand side object.
+func (o *Set) BinaryOp(op token.Token, rhs Object) (Object, error) {
+	return nil, ErrInvalidOperator
+}
+
+// Copy returns a copy of the type.
+func (o *Set) Copy() Object {
+	c := make(map[interface{}]Object)
+	for k, v := range o.Value {
+		c[k] = v.Copy()
+	}
+
+	return &Set{Value: c}
+}
+
+// IsFalsy returns true if the value of the type is falsy.
+func (o *Set) IsFalsy() bool {
+	return len(o.Value) == 0
+}
+
+// Equals returns true if the value of the type
+// is equal to the value of another object.
+func (o *Set) Equals(x Object) bool {
+	t, ok := x.(*Set)
+	if !ok {
+		return false
+	}
+
+	if len(o.Value) != len(t.Value) {
+		return false
+	}
+
+	for k := range o.Value {
+		if _, ok := t.Value[k]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Add adds the elements to the set.
+func (o *Set) Add(elements ...Object) error {
+	for _, e := range elements {
+		key, ok := hashKey(e)
+		if !ok {
+			return fmt.Errorf("unhashable type: %s", e.TypeName())
+		}
+
+		o.Value[key] = e
+	}
+
+	return nil
+}
+
+// Remove removes the elements from the set.
+func (o *Set) Remove(elements ...Object) {
+	for _, e := range elements {
+		if key, ok := hashKey(e); ok {
+			delete(o.Value, key)
+		}
+	}
+}
+
+// Has returns true if the set contains the element.
+func (o *Set) Has(element Object) bool {
+	key, ok := hashKey(element)
+	if !ok {
+		return false
+	}
+
+	_, ok = o.Value[key]
+
+	return ok
+}
+
+// Iterate creates a set iterator. Elements are not in any particular order.
+func (o *Set) Iterate() Iterator {
+	var elements []Object
+	for _, v := range o.Value {
+		elements = append(elements, v)
+	}
+
+	return &ArrayIterator{
+		v: elements,
+		l: len(elements),
+	}
+}
+
+// IndexGet returns the set method for the given name.
+func (o *Set) IndexGet(index Object) (res Object, err error) {
+	strIdx, ok := index.(*String)
+	if !ok {
+		err = ErrInvalidIndexType
+		return
+	}
+
+	switch strIdx.Value {
+	case "add":
+		res = &UserFunction{Value: func(args ...Object) (Object, error) {
+			if err := o.Add(args...); err != nil {
+				return nil, err
+			}
+
+			return UndefinedValue, nil
+		}}
+	case "remove":
+		res = &UserFunction{Value: func(args ...Object) (Object, error) {
+			o.Remove(args...)
+
+			return UndefinedValue, nil
+		}}
+	case "has":
+		res = &UserFunction{Value: func(args ...Object) (Object, error) {
+			if len(args) != 1 {
+				return nil, ErrWrongNumArguments
+			}
+
+			if o.Has(args[0]) {
+				return TrueValue, nil
+			}
+
+			return FalseValue, nil
+		}}
+	case "len":
+		res = &UserFunction{Value: func(args ...Object) (Object, error) {
+			if len(args) != 0 {
+				return nil, ErrWrongNumArguments
+			}
+
+			return &Int{Value: int64(len(o.Value))}, nil
+		}}
+	case "union":
+		res = &UserFunction{Value: o.setOp(func(bool) bool {
+			return true
+		}, true)}
+	case "intersection":
+		res = &UserFunction{Value: o.setOp(func(in bool) bool {
+			return in
+		}, false)}
+	case "difference":
+		res = &UserFunction{Value: o.setOp(func(in bool) bool {
+			return !in
+		}, false)}
+	default:
+		res = UndefinedValue
+	}
+
+	return
+}
+
+// setOp returns a function that creates a new set from the elements
+// of this set selected by keep, and if addOther is true,
+// all the elements of the other set.
+func (o *Set) setOp(keep func(in bool) bool, addOther bool) CallableFunc {
+	return func(args ...Object) (Object, error) {
+		if len(args) != 1 {
+			return nil, ErrWrongNumArguments
+		}
+
+		other, err := toSet(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		res := &Set{Value: make(map[interface{}]Object)}
+		for k, v := range o.Value {
+			if _, in := other.Value[k]; keep(in) {
+				res.Value[k] = v
+			}
+		}
+
+		if addOther {
+			for k, v := range other.Value {
+				if _, ok := res.Value[k]; !ok {
+					res.Value[k] = v
+				}
+			}
+		}
+
+		return res, nil
+	}
+}
+
+// toSet converts a set or an array to a set.
+func toSet(o Object) (*Set, error) {
+	switch o := o.(type) {
+	case *Set:
+		return o, nil
+	case *Array:
+		return NewSet(o.Value...)
+	case *ImmutableArray:
+		return NewSet(o.Value...)
+	}
+
+	return nil, ErrInvalidTypeConversion
+}
@@ -0,0 +1,121 @@
+package objects
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/d5/tengo/compiler/token"
+)
+
+// StringBuilder represents a mutable string that amortizes the allocations
+// of appending many strings.
+type StringBuilder struct {
+	Value strings.Builder
+}
+
+// TypeName returns the name of the type.
+func (o *StringBuilder) TypeName() string {
+	return "string-builder"
+}
+
+func (o *StringBuilder) String() string {
+	return strconv.Quote(o.Value.String())
+}
+
+// BinaryOp returns another object that is the result of
+// a given binary operator and a right-hand side object.
+func (o *StringBuilder) BinaryOp(op token.Token, rhs Object) (Object, error) {
+	return nil, ErrInvalidOperator
+}
+
+// Copy returns a copy of the type.
+func (o *StringBuilder) Copy() Object {
+	c := &StringBuilder{}
+	c.Value.WriteString(o.Value.String())
+
+	return c
+}
+
+// IsFalsy returns true if the value of the type is falsy.
+func (o *StringBuilder) IsFalsy() bool {
+	return o.Value.Len() == 0
+}
+
+// Equals returns true if the value of the type
+// is equal to the value of another object.
+func (o *StringBuilder) Equals(x Object) bool {
+	t, ok := x.(*StringBuilder)
+	if !ok {
+		return false
+	}
+
+	return o.Value.String() == t.Value.String()
+}
+
+// Append appends the values the same way the string '+' operator does:
+// strings are appended as they are, and other values are converted
+// using their String method.
+func (o *StringBuilder) Append(values ...Object) {
+	for _, v := range values {
+		switch v := v.(type) {
+		case *String:
+			o.Value.WriteString(v.Value)
+		default:
+			o.Value.WriteString(v.String())
+		}
+	}
+}
+
+// Len returns the number of the characters.
+func (o *StringBuilder) Len() int {
+	return utf8.RuneCountInString(o.Value.String())
+}
+
+// IndexGet returns the string builder method for the given name.
+func (o *StringBuilder) IndexGet(index Object) (res Object, err error) {
+	strIdx, ok := index.(*String)
+	if !ok {
+		err = ErrInvalidIndexType
+		return
+	}
+
+	switch strIdx.Value {
+	case "append":
+		res = &UserFunction{Value: func(args ...Object) (Object, error) {
+			o.Append(args...)
+
+			return o, nil
+		}}
+	case "string":
+		res = &UserFunction{Value: func(args ...Object) (Object, error) {
+			if len(args) != 0 {
+				return nil, ErrWrongNumArguments
+			}
+
+			return &String{Value: o.Value.String()}, nil
+		}}
+	case "len":
+		res = &UserFunction{Value: func(args ...Object) (Object, error) {
+			if len(args) != 0 {
+				return nil, ErrWrongNumArguments
+			}
+
+			return &Int{Value: int64(o.Len())}, nil
+		}}
+	case "reset":
+		res = &UserFunction{Value: func(args ...Object) (Object, error) {
+			if len(args) != 0 {
+				return nil, ErrWrongNumArguments
+			}
+
+			o.Value.Reset()
+
+			return UndefinedValue, nil
+		}}
+	default:
+		res = UndefinedValue
+	}
+
+	return
+}
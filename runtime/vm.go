@@ -3,6 +3,9 @@ package runtime
 import (
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"sync"
 	"sync/atomic"
 
 	"github.com/d5/tengo/compiler"
@@ -14,7 +17,7 @@ const (
 	// StackSize is the maximum stack size.
 	StackSize = 2048
 
-	// GlobalsSize is the maximum number of global variables.
+	// GlobalsSize is the default number of global variables allocated by NewVM.
 	GlobalsSize = 1024
 
 	// MaxFrames is the maximum number of function frames.
@@ -40,10 +43,27 @@ type VM struct {
 	curInsts    []byte
 	curIPLimit  int
 	ip          int
-	aborting    int64
+	aborting    *int64
+	doneLock    sync.Mutex
+	done        chan struct{}
+	parent      *VM        // set for the child VMs used to call functions
+	children    []*VM      // cached child VMs for Call, one per call depth
+	callDepth   int        // number of the calls in progress on the children
+	forks       *forkGroup // the VMs forked during the current run
+	group       *forkGroup // set for the forked VMs
+	output      io.Writer
+	constCache  *objects.ConstCache // shared with the child VMs
+
+	opcodeCounts *opcodeCounts // nil unless counting is enabled
+	coverage     *coverage     // nil unless coverage is enabled
+
+	evalMaxConstants     int
+	evalMaxInstructions  int
+	evalDisabledBuiltins map[string]bool
 }
 
-// NewVM creates a VM.
+// NewVM creates a VM. If the globals has less than GlobalsSize elements,
+// a new slice of GlobalsSize elements is allocated for the global variables.
 func NewVM(bytecode *compiler.Bytecode, globals []*objects.Object) *VM {
 	if globals == nil {
 		globals = make([]*objects.Object, GlobalsSize)
@@ -53,6 +73,14 @@ func NewVM(bytecode *compiler.Bytecode, globals []*objects.Object) *VM {
 		globals = g
 	}
 
+	return NewVMWithGlobals(bytecode, globals)
+}
+
+// NewVMWithGlobals creates a VM that uses the globals as they are. Unlike
+// NewVM, it does not allocate GlobalsSize global variables, so the globals
+// should have enough elements for all the global variables of the bytecode
+// (i.e. MaxSymbols of the symbol table used to compile the bytecode).
+func NewVMWithGlobals(bytecode *compiler.Bytecode, globals []*objects.Object) *VM {
 	frames := make([]Frame, MaxFrames)
 	frames[0].fn = &objects.CompiledFunction{Instructions: bytecode.Instructions}
 	frames[0].freeVars = nil
@@ -70,12 +98,76 @@ func NewVM(bytecode *compiler.Bytecode, globals []*objects.Object) *VM {
 		curInsts:    frames[0].fn.Instructions,
 		curIPLimit:  len(frames[0].fn.Instructions) - 1,
 		ip:          -1,
+		aborting:    new(int64),
+		forks:       newForkGroup(),
+		constCache:  &objects.ConstCache{},
 	}
 }
 
 // Abort aborts the execution.
 func (v *VM) Abort() {
-	atomic.StoreInt64(&v.aborting, 1)
+	atomic.StoreInt64(v.aborting, 1)
+
+	v.doneLock.Lock()
+	if v.done == nil {
+		v.done = make(chan struct{})
+	}
+	select {
+	case <-v.done: // already closed
+	default:
+		close(v.done)
+	}
+	forks := v.forks
+	v.doneLock.Unlock()
+
+	if forks != nil {
+		forks.abort()
+	}
+}
+
+// Done returns a channel that is closed when the execution is aborted.
+func (v *VM) Done() <-chan struct{} {
+	if v.parent != nil && v.aborting == v.parent.aborting {
+		return v.parent.Done()
+	}
+
+	if v.group != nil && v.aborting == &v.group.aborting {
+		return v.group.done
+	}
+
+	v.doneLock.Lock()
+	defer v.doneLock.Unlock()
+
+	if v.done == nil {
+		v.done = make(chan struct{})
+	}
+
+	return v.done
+}
+
+// SetOutput sets the writer that the output functions (e.g. print) write
+// to. Default output is os.Stdout.
+func (v *VM) SetOutput(w io.Writer) {
+	v.output = w
+}
+
+// Output returns the writer that the output functions write to.
+func (v *VM) Output() io.Writer {
+	if v.parent != nil {
+		return v.parent.Output()
+	}
+
+	if v.output == nil {
+		return os.Stdout
+	}
+
+	return v.output
+}
+
+// ConstCache returns the cache of the constants created by 'const_map' and
+// 'const_array'. The VM and its child VMs share the same cache.
+func (v *VM) ConstCache() *objects.ConstCache {
+	return v.constCache
 }
 
 // Run starts the execution.
@@ -87,12 +179,120 @@ func (v *VM) Run() error {
 	v.curIPLimit = len(v.curInsts) - 1
 	v.framesIndex = 1
 	v.ip = -1
-	atomic.StoreInt64(&v.aborting, 0)
+	atomic.StoreInt64(v.aborting, 0)
+
+	v.doneLock.Lock()
+	v.done = nil
+	v.forks = newForkGroup()
+	forks := v.forks
+	v.doneLock.Unlock()
+
+	// the forked VMs do not outlive the run
+	defer forks.abort()
+
+	if err := v.run(); err != nil {
+		if err == objects.ErrAborted && atomic.LoadInt64(v.aborting) != 0 {
+			return nil
+		}
+
+		return err
+	}
+
+	// check if stack still has some objects left
+	if v.sp > 0 && atomic.LoadInt64(v.aborting) == 0 {
+		return fmt.Errorf("non empty stack after execution")
+	}
+
+	return nil
+}
+
+// Call calls the function with the arguments and returns its return value.
+// Compiled functions and closures run on a child VM that shares the constants
+// and the global variables of v. If v is aborted, Call returns
+// objects.ErrAborted.
+func (v *VM) Call(fn objects.Object, args ...objects.Object) (objects.Object, error) {
+	switch callee := fn.(type) {
+	case *objects.Closure, *objects.CompiledFunction:
+		// runs on the child VM below
+	case objects.InteropCallable:
+		return callee.CallInterop(v, args...)
+	case objects.Callable:
+		return callee.Call(args...)
+	default:
+		return nil, fmt.Errorf("calling non-function: %s", fn.TypeName())
+	}
+
+	// a function called from a builtin function can call back into the
+	// runtime (e.g. by reading a lazy iterator) while the previous child VM
+	// is still running, so each nested call uses its own child VM
+	if v.callDepth == len(v.children) {
+		v.children = append(v.children, v.newChild(v.globals))
+	}
+	child := v.children[v.callDepth]
+	v.callDepth++
+	defer func() { v.callDepth-- }()
+
+	return child.call(fn, args)
+}
+
+func (v *VM) newChild(globals []*objects.Object) *VM {
+	return &VM{
+		constants:    v.constants,
+		stack:        make([]*objects.Object, StackSize),
+		globals:      globals,
+		frames:       make([]Frame, MaxFrames),
+		aborting:     v.aborting,
+		parent:       v,
+		constCache:   v.constCache,
+		opcodeCounts: v.opcodeCounts,
+		coverage:     v.coverage,
+	}
+}
+
+// call runs the function on v using a main function that only calls fn.
+func (v *VM) call(fn objects.Object, args []objects.Object) (objects.Object, error) {
+	if len(args) > 255 {
+		return nil, fmt.Errorf("too many arguments: %d", len(args))
+	}
+
+	v.frames[0].fn = &objects.CompiledFunction{
+		Instructions: compiler.MakeInstruction(compiler.OpCall, len(args)),
+	}
+	v.frames[0].ip = -1
+	v.curFrame = &(v.frames[0])
+	v.curInsts = v.curFrame.fn.Instructions
+	v.curIPLimit = len(v.curInsts) - 1
+	v.framesIndex = 1
+	v.ip = -1
+
+	v.stack[0] = &fn
+	for i := range args {
+		arg := args[i]
+		v.stack[i+1] = &arg
+	}
+	v.sp = len(args) + 1
 
-	for v.ip < v.curIPLimit && (atomic.LoadInt64(&v.aborting) == 0) {
+	if err := v.run(); err != nil {
+		return nil, err
+	}
+
+	if atomic.LoadInt64(v.aborting) != 0 {
+		return nil, objects.ErrAborted
+	}
+
+	return *v.stack[v.sp-1], nil
+}
+
+func (v *VM) run() error {
+	for v.ip < v.curIPLimit && (atomic.LoadInt64(v.aborting) == 0) {
 		v.ip++
 
-		switch compiler.Opcode(v.curInsts[v.ip]) {
+		op := compiler.Opcode(v.curInsts[v.ip])
+		if v.opcodeCounts != nil {
+			atomic.AddInt64(&v.opcodeCounts[op], 1)
+		}
+
+		switch op {
 		case compiler.OpConstant:
 			cidx := int(v.curInsts[v.ip+2]) | int(v.curInsts[v.ip+1])<<8
 			v.ip += 2
@@ -565,6 +765,29 @@ func (v *VM) Run() error {
 
 			v.stack[v.sp-1] = &err
 
+		case compiler.OpErrorKind:
+			value := v.stack[v.sp-2]
+			kind, ok := (*v.stack[v.sp-1]).(*objects.String)
+			if !ok {
+				return fmt.Errorf("invalid error kind type: %s", (*v.stack[v.sp-1]).TypeName())
+			}
+
+			var err objects.Object = &objects.Error{
+				Value: *value,
+				Kind:  kind.Value,
+			}
+
+			v.stack[v.sp-2] = &err
+			v.sp--
+
+		case compiler.OpCoverLine:
+			line := int(v.curInsts[v.ip+2]) | int(v.curInsts[v.ip+1])<<8
+			v.ip += 2
+
+			if v.coverage != nil {
+				v.coverage.add(line)
+			}
+
 		case compiler.OpImmutable:
 			value := v.stack[v.sp-1]
 
@@ -770,7 +993,13 @@ func (v *VM) Run() error {
 					args = append(args, *arg)
 				}
 
-				ret, err := callee.Call(args...)
+				var ret objects.Object
+				var err error
+				if interop, ok := callee.(objects.InteropCallable); ok {
+					ret, err = interop.CallInterop(v, args...)
+				} else {
+					ret, err = callee.Call(args...)
+				}
 				v.sp -= numArgs + 1
 
 				// runtime error
@@ -966,7 +1195,17 @@ func (v *VM) Run() error {
 			iterator := v.stack[v.sp-1]
 			v.sp--
 
-			hasMore := (*iterator).(objects.Iterator).Next()
+			var hasMore bool
+			if it, ok := (*iterator).(objects.InteropIterator); ok {
+				hasMore = it.NextInterop(v)
+			} else {
+				hasMore = (*iterator).(objects.Iterator).Next()
+			}
+			if !hasMore {
+				if it, ok := (*iterator).(objects.ErrorIterator); ok && it.Err() != nil {
+					return it.Err()
+				}
+			}
 
 			if v.sp >= StackSize {
 				return ErrStackOverflow
@@ -1013,16 +1252,122 @@ func (v *VM) Run() error {
 				return err
 			}
 
+		case compiler.OpUnpack:
+			numElements := int(v.curInsts[v.ip+1])
+			hasRest := int(v.curInsts[v.ip+2]) == 1
+			v.ip += 2
+
+			value := *v.stack[v.sp-1]
+			v.sp--
+
+			var elements []objects.Object
+			switch value := value.(type) {
+			case *objects.Array:
+				elements = value.Value
+			case *objects.ImmutableArray:
+				elements = value.Value
+			default:
+				return fmt.Errorf("cannot unpack %s", value.TypeName())
+			}
+
+			if v.sp+numElements > StackSize {
+				return ErrStackOverflow
+			}
+
+			// push in reverse order so that the first element is on top;
+			// missing elements are undefined and extra elements are ignored.
+			for i := numElements - 1; i >= 0; i-- {
+				var elem objects.Object
+				if hasRest && i == numElements-1 {
+					var rest []objects.Object
+					if i < len(elements) {
+						rest = append(rest, elements[i:]...)
+					}
+					elem = &objects.Array{Value: rest}
+				} else if i < len(elements) {
+					elem = elements[i]
+				} else {
+					elem = objects.UndefinedValue
+				}
+
+				v.stack[v.sp] = &elem
+				v.sp++
+			}
+
+		case compiler.OpSelect:
+			numCases := int(v.curInsts[v.ip+1])
+			hasDefault := int(v.curInsts[v.ip+2]) == 1
+			v.ip += 2
+
+			// each case has channel, value, and send flag
+			cases := make([]objects.SelectCase, numCases)
+			base := v.sp - numCases*3
+			for i := range cases {
+				ch, ok := (*v.stack[base+i*3]).(*objects.Channel)
+				if !ok {
+					return fmt.Errorf("select case requires channel, got: %s", (*v.stack[base+i*3]).TypeName())
+				}
+
+				cases[i].Channel = ch
+				cases[i].Send = *v.stack[base+i*3+2] == objects.TrueValue
+				if cases[i].Send {
+					cases[i].Value = objects.ForkCopy(*v.stack[base+i*3+1])
+				}
+			}
+			v.sp = base
+
+			chosen, received, err := objects.Select(cases, !hasDefault, v.Done())
+			if err != nil {
+				return err
+			}
+
+			if v.sp+2 > StackSize {
+				return ErrStackOverflow
+			}
+
+			// push received value and index of chosen case (-1 for default)
+			var index objects.Object = &objects.Int{Value: int64(chosen)}
+			v.stack[v.sp] = &received
+			v.stack[v.sp+1] = &index
+			v.sp += 2
+
+		case compiler.OpUnpackMap:
+			numKeys := int(v.curInsts[v.ip+1])
+			v.ip++
+
+			var kv map[string]objects.Object
+			switch value := (*v.stack[v.sp-numKeys-1]).(type) {
+			case *objects.Map:
+				kv = value.Value
+			case *objects.ImmutableMap:
+				kv = value.Value
+			default:
+				return fmt.Errorf("cannot unpack %s", value.TypeName())
+			}
+
+			values := make([]objects.Object, numKeys)
+			for i := 0; i < numKeys; i++ {
+				key := (*v.stack[v.sp-numKeys+i]).(*objects.String).Value
+
+				value, ok := kv[key]
+				if !ok {
+					value = objects.UndefinedValue
+				}
+				values[i] = value
+			}
+			v.sp -= numKeys + 1
+
+			// push in reverse order so that the first value is on top
+			for i := numKeys - 1; i >= 0; i-- {
+				v.stack[v.sp] = &values[i]
+				v.sp++
+			}
+
 		default:
 			return fmt.Errorf("unknown opcode: %d", v.curInsts[v.ip])
 		}
 	}
 
-	// check if stack still has some objects left
-	if v.sp > 0 && atomic.LoadInt64(&v.aborting) == 0 {
-		return fmt.Errorf("non empty stack after execution")
-	}
-
 	return nil
 }
 
@@ -1217,6 +1562,6 @@ func indexAssign(dst, src *objects.Object, selectors []*objects.Object) error {
 func init() {
 	builtinFuncs = make([]objects.Object, len(objects.Builtins))
 	for i, b := range objects.Builtins {
-		builtinFuncs[i] = &objects.BuiltinFunction{Value: b.Func}
+		builtinFuncs[i] = &objects.BuiltinFunction{Value: b.Func, Interop: b.InteropFunc}
 	}
 }
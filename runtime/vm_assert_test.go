@@ -0,0 +1,19 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestAssert(t *testing.T) {
+	expect(t, `out = assert(true, "ok")`, objects.UndefinedValue)
+	expect(t, `out = assert(1)`, objects.UndefinedValue)
+	expect(t, `assert([1], "non-empty"); out = 1`, 1)
+
+	expectError(t, `assert(false, "failed")`)
+	expectError(t, `assert(0)`)
+	expectError(t, `assert("", 1)`)
+	expectError(t, `assert()`)
+	expectError(t, `assert(true, "a", "b")`)
+}
@@ -0,0 +1,36 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestBits(t *testing.T) {
+	expect(t, `out = bit_set(0, 0)`, 1)
+	expect(t, `out = bit_set(5, 1)`, 7)
+	expect(t, `out = bit_set(4, 2)`, 4)
+	expect(t, `out = bit_set(0, 63)`, int64(-9223372036854775808))
+	expect(t, `out = bit_clear(7, 1)`, 5)
+	expect(t, `out = bit_clear(5, 1)`, 5)
+	expect(t, `out = bit_clear(-1, 63)`, int64(9223372036854775807))
+	expect(t, `out = bit_test(5, 0)`, true)
+	expect(t, `out = bit_test(5, 1)`, false)
+	expect(t, `out = bit_test(-1, 63)`, true)
+
+	expect(t, `out = popcount(0)`, 0)
+	expect(t, `out = popcount(255)`, 8)
+	expect(t, `out = popcount(-1)`, 64)
+	expect(t, `out = leading_zeros(1)`, 63)
+	expect(t, `out = leading_zeros(0)`, 64)
+	expect(t, `out = leading_zeros(-1)`, 0)
+	expect(t, `out = trailing_zeros(8)`, 3)
+	expect(t, `out = trailing_zeros(0)`, 64)
+
+	expectError(t, `bit_set(1, 64)`)
+	expectError(t, `bit_clear(1, -1)`)
+	expectError(t, `bit_test(1, 100)`)
+	expectError(t, `bit_set(1.0, 1)`)
+	expectError(t, `bit_set(1, "a")`)
+	expectError(t, `bit_test(1)`)
+	expectError(t, `popcount("a")`)
+	expectError(t, `popcount(1, 2)`)
+}
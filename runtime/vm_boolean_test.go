@@ -11,6 +11,8 @@ func TestBoolean(t *testing.T) {
 	expect(t, `out = 1 < 2`, true)
 	expect(t, `out = 1 > 2`, false)
 	expect(t, `out = 1 < 1`, false)
+	expect(t, `x := 0; out = x<-1`, false)
+	expect(t, `x := -2; out = x<-1`, true)
 	expect(t, `out = 1 > 2`, false)
 	expect(t, `out = 1 == 1`, true)
 	expect(t, `out = 1 != 1`, false)
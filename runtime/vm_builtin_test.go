@@ -1,7 +1,9 @@
 package runtime_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/d5/tengo/objects"
 )
@@ -10,7 +12,16 @@ func TestBuiltinFunction(t *testing.T) {
 	expect(t, `out = len("")`, 0)
 	expect(t, `out = len("four")`, 4)
 	expect(t, `out = len("hello world")`, 11)
-	expectError(t, `len(1)`)
+	expect(t, `out = len("héllo")`, 5)
+	expect(t, `out = len("世界")`, 2)
+	expect(t, `out = len(bytes("héllo"))`, 6)
+	expect(t, `out = len(bytes(""))`, 0)
+	expect(t, `out = len([1, 2, 3])`, 3)
+	expect(t, `out = len(immutable([1, 2]))`, 2)
+	expect(t, `out = len({a: 1, b: 2})`, 2)
+	expect(t, `out = len(immutable({a: 1}))`, 1)
+	expect(t, `out = len(1)`, &objects.Error{Value: &objects.String{Value: "unsupported type for 'len' function: int"}})
+	expect(t, `out = len(undefined)`, &objects.Error{Value: &objects.String{Value: "unsupported type for 'len' function: undefined"}})
 	expectError(t, `len("one", "two")`)
 
 	expect(t, `out = copy(1)`, 1)
@@ -20,9 +31,26 @@ func TestBuiltinFunction(t *testing.T) {
 	expect(t, `out = append([1, 2, 3], 4, 5, 6)`, ARR{1, 2, 3, 4, 5, 6})
 	expect(t, `out = append([1, 2, 3], "foo", false)`, ARR{1, 2, 3, "foo", false})
 
+	expect(t, `out = extend([1, 2], [3, 4])`, ARR{1, 2, 3, 4})
+	expect(t, `out = extend([1, 2], [])`, ARR{1, 2})
+	expect(t, `out = extend([], immutable([1, "a"]))`, ARR{1, "a"})
+	expect(t, `a := [1]; extend(a, [2, 3]); out = a`, ARR{1, 2, 3})
+	expect(t, `a := [1]; out = extend(a, a)`, ARR{1, 1})
+	expectError(t, `extend(immutable([1]), [2])`)
+	expectError(t, `extend([1], 2)`)
+	expectError(t, `extend([1])`)
+
 	expect(t, `out = int(1)`, 1)
 	expect(t, `out = int(1.8)`, 1)
 	expect(t, `out = int("-522")`, -522)
+	expect(t, `out = int(-1.8)`, -1) // truncated toward zero
+	expect(t, `out = int(-0.5)`, 0)
+	expect(t, `out = int('가')`, 44032)
+	expect(t, `out = int("00123")`, 123)
+	expect(t, `out = int("abc")`, errorObject(`cannot convert string to int: "abc"`))
+	expect(t, `out = int("1.5")`, errorObject(`cannot convert string to int: "1.5"`))
+	expect(t, `out = int("")`, errorObject(`cannot convert string to int: ""`))
+	expect(t, `out = int("abc", 0)`, 0)
 	expect(t, `out = int(true)`, 1)
 	expect(t, `out = int(false)`, 0)
 	expect(t, `out = int('8')`, 56)
@@ -43,16 +71,31 @@ func TestBuiltinFunction(t *testing.T) {
 	expect(t, `out = string('8')`, "8")
 	expect(t, `out = string([1,8.1,true,3])`, "[1, 8.1, true, 3]")
 	expect(t, `out = string({b: "foo"})`, `{b: "foo"}`)
-	expect(t, `out = string(undefined)`, objects.UndefinedValue) // not "undefined"
+	expect(t, `out = string(undefined)`, "") // not "undefined"
+	expect(t, `out = string(8)`, "8")
+	expect(t, `out = string(-1.25)`, "-1.25")
+	expect(t, `out = string('가')`, "가") // the rune, not 44032
+	expect(t, `out = string(bytes("héllo"))`, "héllo")
+	expect(t, `out = string(immutable([1, "a"]))`, `[1, "a"]`)
+	expect(t, `out = string({})`, "{}")
+	expect(t, `out = string(time(0))`, time.Unix(0, 0).Format(time.RFC3339))
+	expect(t, `out = string(time(1500000000))`, time.Unix(1500000000, 0).Format(time.RFC3339))
+	expect(t, `out = string(time(0) + 1500)`, time.Unix(0, 0).Format(time.RFC3339)) // no fractional seconds
 	expect(t, `out = string(1, "-522")`, "1")
 	expect(t, `out = string(undefined, "-522")`, "-522") // not "undefined"
 
 	expect(t, `out = float(1)`, 1.0)
 	expect(t, `out = float(1.8)`, 1.8)
 	expect(t, `out = float("-52.2")`, -52.2)
-	expect(t, `out = float(true)`, objects.UndefinedValue)
-	expect(t, `out = float(false)`, objects.UndefinedValue)
-	expect(t, `out = float('8')`, objects.UndefinedValue)
+	expect(t, `out = float(true)`, 1.0)
+	expect(t, `out = float(false)`, 0.0)
+	expect(t, `out = float('8')`, 56.0)
+	expect(t, `out = float(9007199254740993)`, 9007199254740992.0)
+	expect(t, `out = float(-3)`, -3.0)
+	expect(t, `out = float("1e3")`, 1000.0)
+	expect(t, `out = float("abc")`, errorObject(`cannot convert string to float: "abc"`))
+	expect(t, `out = float("")`, errorObject(`cannot convert string to float: ""`))
+	expect(t, `out = float("abc", 1.5)`, 1.5)
 	expect(t, `out = float([1,8.1,true,3])`, objects.UndefinedValue)
 	expect(t, `out = float({a: 1, b: "foo"})`, objects.UndefinedValue)
 	expect(t, `out = float(undefined)`, objects.UndefinedValue)
@@ -165,3 +208,41 @@ func TestBuiltinFunction(t *testing.T) {
 	expect(t, `out = type_name(undefined)`, "undefined")
 	expect(t, `out = type_name(error("err"))`, "error")
 }
+
+func TestBuiltinBool(t *testing.T) {
+	// bool(x) should be the same as the condition evaluation of the VM
+	for _, tc := range []struct {
+		value    string
+		expected bool
+	}{
+		{`0`, false},
+		{`""`, false},
+		{`false`, false},
+		{`char(0)`, false},
+		{`bytes("")`, false},
+		{`[]`, false},
+		{`immutable([])`, false},
+		{`{}`, false},
+		{`immutable({})`, false},
+		{`set()`, false},
+		{`undefined`, false},
+		{`error(1)`, false},
+		{`1`, true},
+		{`-1`, true},
+		{`0.0`, true}, // all floats except for NaN are truthy
+		{`1.5`, true},
+		{`"0"`, true},
+		{`true`, true},
+		{`'a'`, true},
+		{`bytes("a")`, true},
+		{`[0]`, true},
+		{`{a: undefined}`, true},
+		{`set([1])`, true},
+		{`func() {}`, true},
+		{`len`, true},
+	} {
+		expect(t, fmt.Sprintf(`out = bool(%s)`, tc.value), tc.expected)
+		expect(t, fmt.Sprintf(`out = (%s) ? true : false`, tc.value), tc.expected)
+		expect(t, fmt.Sprintf(`out = false; if (%s) { out = true }`, tc.value), tc.expected)
+	}
+}
@@ -0,0 +1,21 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestCallMethod(t *testing.T) {
+	expect(t, `m := {add: func(a, b) { return a + b }}; out = call_method(m, "add", 1, 2)`, 3)
+	expect(t, `m := {f: func() { return "f" }}; out = call_method(m, "f")`, "f")
+	expect(t, `n := 10; m := {f: func(x) { return n * x }}; out = call_method(m, "f", 2)`, 20)
+	expect(t, `m := immutable({f: len}); out = call_method(m, "f", [1, 2])`, 2)
+	expect(t, `name := "has"; s := set([1]); out = call_method(s, name, 1)`, true)
+	expect(t, `b := builder(); call_method(b, "append", "a", "b"); out = call_method(b, "string")`, "ab")
+
+	expectError(t, `call_method({}, "f")`)
+	expectError(t, `call_method({f: 1}, "f")`)
+	expectError(t, `call_method({f: func() {}}, 1)`)
+	expectError(t, `call_method(1, "f")`)
+	expectError(t, `call_method({f: func() {}})`)
+	expectError(t, `call_method({f: func(a) {}}, "f")`)
+}
@@ -7,3 +7,16 @@ func TestCall(t *testing.T) {
 	expect(t, `a := { b: { c: func(x) { return x + 2 } } }; out = a.b.c(5)`, 7)
 	expect(t, `a := { b: { c: func(x) { return x + 2 } } }; out = a["b"].c(5)`, 7)
 }
+
+func TestCall_Nested(t *testing.T) {
+	// a callback calls back into the runtime while the previous callback
+	// is still running
+	expect(t, `
+it := lazy_map(range(0, 3), func(x) { return x * 10 })
+out = group_by([1, 2], func(x) { return string(len(collect(it))) + "-" + string(x) })`, MAP{"3-1": ARR{1}, "0-2": ARR{2}})
+	expect(t, `
+it := lazy_map(range(0, 3), func(x) { return x * 10 })
+out = times(2, func(i) { return [i, collect(it)] })`, ARR{ARR{0, ARR{0, 10, 20}}, ARR{1, ARR{}}})
+	expect(t, `out = times(2, func(i) { return times(2, func(j) { return times(2, func(k) { return i * 4 + j * 2 + k }) }) })`,
+		ARR{ARR{ARR{0, 1}, ARR{2, 3}}, ARR{ARR{4, 5}, ARR{6, 7}}})
+}
@@ -0,0 +1,62 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestCaseConversions(t *testing.T) {
+	expect(t, `out = to_camel("user_id")`, "userId")
+	expect(t, `out = to_camel("user-id")`, "userId")
+	expect(t, `out = to_camel("User ID")`, "userId")
+	expect(t, `out = to_camel("HTTPServer")`, "httpServer")
+	expect(t, `out = to_camel("__a__b__")`, "aB")
+	expect(t, `out = to_camel("")`, "")
+	expect(t, `out = to_camel("größe_maß")`, "größeMaß")
+
+	expect(t, `out = to_snake("userId")`, "user_id")
+	expect(t, `out = to_snake("userID")`, "user_id")
+	expect(t, `out = to_snake("parseHTMLFile")`, "parse_html_file")
+	expect(t, `out = to_snake("UserName")`, "user_name")
+	expect(t, `out = to_snake("user-name")`, "user_name")
+	expect(t, `out = to_snake("version2Id")`, "version2_id")
+
+	expect(t, `out = to_kebab("userId")`, "user-id")
+	expect(t, `out = to_kebab("Hello World")`, "hello-world")
+	expect(t, `out = to_kebab("XMLHttpRequest")`, "xml-http-request")
+
+	// already converted strings are stable
+	expect(t, `out = to_camel("userId")`, "userId")
+	expect(t, `out = to_snake("user_id")`, "user_id")
+	expect(t, `out = to_kebab("user-id")`, "user-id")
+
+	// round trips
+	expect(t, `out = to_snake(to_camel("parse_html_file"))`, "parse_html_file")
+	expect(t, `out = to_kebab(to_snake("a-b-c"))`, "a-b-c")
+	expect(t, `out = to_camel(to_snake("parseHtmlFile"))`, "parseHtmlFile")
+
+	expectError(t, `to_camel()`)
+	expectError(t, `to_snake(1)`)
+	expectError(t, `to_kebab("a", "b")`)
+}
+
+func TestUpperLowerFold(t *testing.T) {
+	expect(t, `out = to_upper("hello")`, "HELLO")
+	expect(t, `out = to_upper("héllo wörld")`, "HÉLLO WÖRLD")
+	expect(t, `out = to_upper("ὀδυσσεύς")`, "ὈΔΥΣΣΕΎΣ")
+	expect(t, `out = to_lower("ΑΒΓ")`, "αβγ")
+	expect(t, `out = to_lower("ÇA VA")`, "ça va")
+	expect(t, `out = to_lower("")`, "")
+
+	expect(t, `out = fold("Hello") == fold("hELLO")`, true)
+	expect(t, `out = fold("ὈΔΥΣΣΕΎΣ") == fold("ὀδυσσεύς")`, true)
+	expect(t, `out = fold("σ") == fold("ς")`, true)
+	expect(t, `out = fold("K") == fold("K")`, true) // Kelvin sign
+	expect(t, `out = fold("ſ") == fold("S")`, true) // long s
+	expect(t, `out = fold("Straße") == fold("STRASSE")`, false)
+	expect(t, `out = fold("a") == fold("b")`, false)
+
+	expectError(t, `to_upper(1)`)
+	expectError(t, `to_lower('a')`)
+	expectError(t, `fold()`)
+	expectError(t, `fold("a", "b")`)
+}
@@ -0,0 +1,27 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestChannel(t *testing.T) {
+	expect(t, `ch := channel(2); ch.send(1); ch.send("a"); out = [ch.receive(), ch.receive()]`, ARR{1, "a"})
+	expect(t, `ch := channel(3); ch.send(1); ch.send(2); out = [len(ch), ch.len()]`, ARR{2, 2})
+	expect(t, `out = type_name(channel())`, "channel")
+	expect(t, `ch := channel(1); c := copy(ch); c.send(5); out = ch.receive()`, 5)
+
+	// receiving from a closed channel
+	expect(t, `ch := channel(1); ch.send(1); ch.close(); out = [ch.receive(), ch.receive()]`, ARR{1, objects.UndefinedValue})
+	expect(t, `ch := channel(); ch.close(); out = ch.receive()`, objects.UndefinedValue)
+
+	expectError(t, `ch := channel(1); ch.close(); ch.send(1)`)
+	expectError(t, `ch := channel(); ch.close(); ch.close()`)
+	expectError(t, `channel(-1)`)
+	expect(t, `out = len(channel(65536))`, 0)
+	expect(t, `out = channel(65537)`, errorObject("channel size is too large: 65537 (max: 65536)"))
+	expect(t, `out = is_error(channel(1 << 40))`, true)
+	expectError(t, `channel("a")`)
+	expectError(t, `channel(1).send()`)
+}
@@ -0,0 +1,44 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestCheckedArithmetic(t *testing.T) {
+	overflow := &objects.Error{Value: &objects.String{Value: "integer overflow"}}
+
+	expect(t, `out = add_checked(1, 2)`, 3)
+	expect(t, `out = add_checked(9223372036854775806, 1)`, int64(9223372036854775807))
+	expect(t, `out = add_checked(-9223372036854775807, -1)`, int64(-9223372036854775808))
+	expect(t, `min := -9223372036854775807 - 1; out = add_checked(9223372036854775807, min)`, -1)
+	expect(t, `out = add_checked(9223372036854775807, 1)`, overflow)
+	expect(t, `min := -9223372036854775807 - 1; out = add_checked(min, -1)`, overflow)
+
+	expect(t, `out = sub_checked(1, 2)`, -1)
+	expect(t, `out = sub_checked(-9223372036854775807, 1)`, int64(-9223372036854775808))
+	expect(t, `min := -9223372036854775807 - 1; out = sub_checked(-1, min)`, int64(9223372036854775807))
+	expect(t, `min := -9223372036854775807 - 1; out = sub_checked(min, 1)`, overflow)
+	expect(t, `min := -9223372036854775807 - 1; out = sub_checked(0, min)`, overflow)
+
+	expect(t, `out = mul_checked(3, -4)`, -12)
+	expect(t, `min := -9223372036854775807 - 1; out = mul_checked(0, min)`, 0)
+	expect(t, `min := -9223372036854775807 - 1; out = mul_checked(min, 1)`, int64(-9223372036854775808))
+	expect(t, `out = mul_checked(4611686018427387903, 2)`, int64(9223372036854775806))
+	expect(t, `out = mul_checked(4611686018427387904, 2)`, overflow)
+	expect(t, `min := -9223372036854775807 - 1; out = mul_checked(min, -1)`, overflow)
+	expect(t, `min := -9223372036854775807 - 1; out = mul_checked(-1, min)`, overflow)
+	expect(t, `out = mul_checked(3037000500, 3037000500)`, overflow)
+
+	expect(t, `out = div_checked(7, 2)`, 3)
+	expect(t, `min := -9223372036854775807 - 1; out = div_checked(min, 1)`, int64(-9223372036854775808))
+	expect(t, `min := -9223372036854775807 - 1; out = div_checked(min, -1)`, overflow)
+	expect(t, `out = div_checked(1, 0)`, &objects.Error{Value: &objects.String{Value: "division by zero"}})
+
+	expect(t, `out = is_error(add_checked(9223372036854775807, 1))`, true)
+
+	expectError(t, `add_checked(1)`)
+	expectError(t, `add_checked(1, 2.0)`)
+	expectError(t, `mul_checked("a", 2)`)
+}
@@ -0,0 +1,28 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestChunk(t *testing.T) {
+	expect(t, `out = chunk([1, 2, 3, 4], 2)`, ARR{ARR{1, 2}, ARR{3, 4}})
+	expect(t, `out = chunk([1, 2, 3, 4, 5], 2)`, ARR{ARR{1, 2}, ARR{3, 4}, ARR{5}})
+	expect(t, `out = chunk([1, 2, 3], 1)`, ARR{ARR{1}, ARR{2}, ARR{3}})
+	expect(t, `out = chunk([1, 2, 3], 5)`, ARR{ARR{1, 2, 3}})
+	expect(t, `out = chunk([], 3)`, ARR{})
+	expect(t, `out = chunk(immutable([1, 2, 3]), 2)`, ARR{ARR{1, 2}, ARR{3}})
+
+	// chunks are new arrays
+	expect(t, `a := [1, 2, 3]; c := chunk(a, 2); c[0][0] = 9; out = a`, ARR{1, 2, 3})
+	expect(t, `c := chunk([1, 2, 3], 2); c[0] = append(c[0], 5); out = c`, ARR{ARR{1, 2, 5}, ARR{3}})
+
+	// invalid size
+	expect(t, `out = chunk([1, 2], 0)`, &objects.Error{Value: &objects.String{Value: "invalid chunk size: 0"}})
+	expect(t, `out = chunk([1, 2], -1)`, &objects.Error{Value: &objects.String{Value: "invalid chunk size: -1"}})
+
+	expectError(t, `chunk([1, 2], "2")`)
+	expectError(t, `chunk("abc", 2)`)
+	expectError(t, `chunk([1, 2])`)
+}
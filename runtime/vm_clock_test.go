@@ -0,0 +1,67 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestClock(t *testing.T) {
+	expect(t, `out = is_time(now())`, true)
+	expect(t, `t := unix_now(); out = t > 1500000000 && t < 5000000000`, true)
+	expect(t, `t := unix_nano(); out = t > 1500000000000000000`, true)
+	expect(t, `a := unix_nano(); b := unix_nano(); out = b >= a`, true)
+	expect(t, `out = unix_nano() / 1000000000 - unix_now() <= 1`, true)
+
+	expect(t, `out = since(now()) >= 0`, true)
+	expect(t, `out = since(time(unix_now() - 10)) >= 10000000000`, true)
+	expect(t, `out = since(unix_now() - 10) >= 10000000000`, true)
+	expect(t, `out = since(time(unix_now() + 3600)) < 0`, true)
+
+	expectError(t, `now(1)`)
+	expectError(t, `unix_now(1)`)
+	expectError(t, `unix_nano(1)`)
+	expectError(t, `since()`)
+	expectError(t, `since("a")`)
+}
+
+func TestSleep(t *testing.T) {
+	expect(t, `a := unix_nano(); sleep(10000000); out = unix_nano() - a >= 10000000`, true)
+	expect(t, `sleep(0); sleep(-1); out = 1`, 1)
+
+	expectError(t, `sleep()`)
+	expectError(t, `sleep(1.0)`)
+	expectError(t, `sleep("1s")`)
+}
+
+func TestDurations(t *testing.T) {
+	expect(t, `out = parse_duration("1h30m")`, 5400000000000)
+	expect(t, `out = parse_duration("1h2m3.5s")`, 3723500000000)
+	expect(t, `out = parse_duration("-1.5h")`, -5400000000000)
+	expect(t, `out = parse_duration("300ms")`, 300000000)
+	expect(t, `out = parse_duration("0")`, 0)
+	expect(t, `out = is_error(parse_duration("1x"))`, true)
+	expect(t, `out = is_error(parse_duration(""))`, true)
+
+	expect(t, `out = format_duration(5400000000000)`, "1h30m0s")
+	expect(t, `out = format_duration(1500)`, "1.5µs")
+	expect(t, `out = format_duration(-90000000000)`, "-1m30s")
+	expect(t, `out = format_duration(0)`, "0s")
+	expect(t, `out = format_duration(parse_duration("90s"))`, "1m30s")
+
+	expectError(t, `parse_duration(1)`)
+	expectError(t, `parse_duration()`)
+	expectError(t, `format_duration("1h")`)
+	expectError(t, `format_duration(1, 2)`)
+}
+
+func TestTimeit(t *testing.T) {
+	expect(t, `r := timeit(func() { sleep(1000000); return 5 }); out = [r[0], r[1] >= 1000000]`, ARR{5, true})
+	expect(t, `r := timeit(func(a, b) { return a + b }, 1, 2); out = [r[0], is_int(r[1]), r[1] > 0]`, ARR{3, true, true})
+	expect(t, `out = timeit(len, [1, 2])[0]`, 2)
+	expect(t, `out = timeit(func() {})[0]`, objects.UndefinedValue)
+
+	expectError(t, `timeit(func() { return 1 + "a" + [] })`)
+	expectError(t, `timeit(1)`)
+	expectError(t, `timeit()`)
+}
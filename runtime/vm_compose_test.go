@@ -0,0 +1,34 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestCompose(t *testing.T) {
+	expect(t, `inc := func(x) { return x + 1 }; double := func(x) { return x * 2 }; out = compose(inc, double)(5)`, 11)
+	expect(t, `inc := func(x) { return x + 1 }; double := func(x) { return x * 2 }; out = compose(double, inc)(5)`, 12)
+	expect(t, `out = compose(func(x) { return x + 1 })(1)`, 2)
+	expect(t, `out = compose(len, string)(12345)`, 5)
+	expect(t, `out = type_name(compose(len))`, "user-function")
+
+	expectError(t, `compose(func(x) { return x })(1, 2)`)
+	expectError(t, `compose(func(x) { return x })()`)
+	expectError(t, `compose(func(x, y) { return x })(1)`)
+	expectError(t, `compose(1)`)
+	expectError(t, `compose()`)
+}
+
+func TestPipe(t *testing.T) {
+	expect(t, `
+inc := func(x) { return x + 1 }
+double := func(x) { return x * 2 }
+out = pipe(inc, double, string)(5)
+`, "12")
+	expect(t, `out = pipe(string, len, func(n) { return n * 10 })(12345)`, 50)
+	expect(t, `out = pipe(func(x) { return error(x) }, is_error)(1)`, true)
+	expect(t, `f := pipe(func(x) { return x + 1 }); out = [f(1), f(2)]`, ARR{2, 3})
+
+	expectError(t, `pipe(func(x) { return x + "a" + [] })(1)`)
+	expectError(t, `pipe(func(x) { return x }, 1)`)
+	expectError(t, `pipe()`)
+}
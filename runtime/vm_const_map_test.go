@@ -0,0 +1,36 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestConstMapArray(t *testing.T) {
+	expect(t, `out = const_map("a", 1, "b", "x")`, IMAP{"a": 1, "b": "x"})
+	expect(t, `out = const_map()`, IMAP{})
+	expect(t, `out = const_array(1, "a", 2.5)`, IARR{1, "a", 2.5})
+	expect(t, `out = const_array()`, IARR{})
+	expect(t, `m := const_map("a", 1); out = [m.a, m["b"], is_immutable_map(m)]`, ARR{1, objects.UndefinedValue, true})
+
+	// immutable
+	expectError(t, `m := const_map("a", 1); m.a = 2`)
+	expectError(t, `m := const_map("a", 1); m.b = 2`)
+	expectError(t, `a := const_array(1); a[0] = 2`)
+
+	// the same values are reused
+	expect(t, `out = const_map("a", 1, "b", 2) == const_map("b", 2, "a", 1)`, true)
+	expect(t, `out = same(const_map("a", 1, "b", 2), const_map("b", 2, "a", 1))`, true)
+	expect(t, `out = same(const_array(1, 2), const_array(1, 2))`, true)
+	expect(t, `f := func() { return const_array("x", const_array(1)) }; out = same(f(), f())`, true)
+	expect(t, `out = same(const_array(1, 2), const_array(2, 1))`, false)
+	expect(t, `out = same(const_array(1), const_array(1.0))`, false)
+	expect(t, `out = same(const_array(1), immutable([1]))`, false)
+
+	// the containers of mutable values are not shared
+	expect(t, `a := [1]; out = [same(const_array(a), const_array(a)), const_array(a) == const_array([1])]`, ARR{false, true})
+	expect(t, `a := const_map("x", [1]); b := const_map("x", [1]); b.x[0] = 2; out = a.x[0]`, 1)
+
+	expectError(t, `const_map("a")`)
+	expectError(t, `const_map(1, 2)`)
+}
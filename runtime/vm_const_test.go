@@ -0,0 +1,28 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestConst(t *testing.T) {
+	expect(t, `const a := 5; out = a`, 5)
+	expect(t, `const a := 5; b := a * 2; out = b`, 10)
+	expect(t, `const a := [1, 2]; a[0] = 3; out = a`, ARR{3, 2}) // elements can be modified
+	expect(t, `const a := 1; out = func() { return a + 1 }()`, 2)
+	expect(t, `const a := 1; out = func() { a := 2; a = 3; return a }()`, 3) // shadowed in function scope
+	expect(t, `out = func() { const a := 4; return func() { return a }() }()`, 4)
+
+	// 'const' can be a map key and a selector
+	expect(t, `m := {const: 1}; out = m.const`, 1)
+	expect(t, `m := {}; m.const = 2; out = m["const"]`, 2)
+	expect(t, `const a := {const: 3}; out = a.const`, 3)
+
+	expectError(t, `const a := 5; a = 6`)
+	expectError(t, `const a := 5; a += 1`)
+	expectError(t, `const a := 5; a++`)
+	expectError(t, `const a := 5; a := 6`)
+	expectError(t, `a := 5; const a := 6`)
+	expectError(t, `const a := 5; a, b := [1, 2]`)
+	expectError(t, `const a := 5; func() { a = 6 }()`)
+	expectError(t, `func() { const a := 5; func() { a = 6 }() }()`)
+}
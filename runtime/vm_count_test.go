@@ -0,0 +1,25 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	expect(t, `out = count([1, 2, 1, 3, 1], 1)`, 3)
+	expect(t, `out = count([1, 2, 3], 4)`, 0)
+	expect(t, `out = count(["a", "b", "a"], "a")`, 2)
+	expect(t, `out = count([[1], [2], [1]], [1])`, 2)
+	expect(t, `out = count([{a: 1}, {a: 1}, {b: 1}], {a: 1})`, 2)
+	expect(t, `out = count(immutable([true, false, true]), true)`, 2)
+	expect(t, `out = count([], 1)`, 0)
+
+	expect(t, `out = count_by([1, 2, 3, 4, 5, 6], func(x) { return x % 2 == 0 })`, 3)
+	expect(t, `out = count_by([1, 3], func(x) { return x % 2 == 0 })`, 0)
+	expect(t, `out = count_by([1, "a", 2.0], is_string)`, 1)
+	expect(t, `out = count_by([], func(x) { return true })`, 0)
+
+	expectError(t, `count_by([1, 2], func(x) { return x + "a" })`)
+	expectError(t, `count("aab", "a")`)
+	expectError(t, `count([1])`)
+	expectError(t, `count_by([1])`)
+}
@@ -0,0 +1,46 @@
+package runtime
+
+import (
+	"sort"
+	"sync"
+)
+
+// coverage is the set of the executed source lines.
+type coverage struct {
+	lock  sync.Mutex
+	lines map[int]bool
+}
+
+func (c *coverage) add(line int) {
+	c.lock.Lock()
+	c.lines[line] = true
+	c.lock.Unlock()
+}
+
+// EnableCoverage makes the VM record the source lines of the executed
+// statements and resets the recorded lines. The bytecode should be
+// compiled with Compiler.EnableCoverage. Coverage is disabled by default.
+func (v *VM) EnableCoverage() {
+	v.coverage = &coverage{lines: make(map[int]bool)}
+	v.children = nil // the cached child VMs have the previous coverage
+}
+
+// CoveredLines returns the sorted line numbers of the statements executed
+// since EnableCoverage was called. It returns nil if coverage is not
+// enabled.
+func (v *VM) CoveredLines() []int {
+	if v.coverage == nil {
+		return nil
+	}
+
+	v.coverage.lock.Lock()
+	defer v.coverage.lock.Unlock()
+
+	lines := make([]int, 0, len(v.coverage.lines))
+	for line := range v.coverage.lines {
+		lines = append(lines, line)
+	}
+	sort.Ints(lines)
+
+	return lines
+}
@@ -0,0 +1,23 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestCurry(t *testing.T) {
+	expect(t, `f := curry(func(a, b, c) { return a + b + c }, 3); out = f(1)(2)(3)`, 6)
+	expect(t, `f := curry(func(a, b, c) { return [a, b, c] }, 3); out = f(1)(2)(3)`, ARR{1, 2, 3})
+	expect(t, `f := curry(func(a) { return a * 2 }, 1); out = f(4)`, 8)
+	expect(t, `f := curry(func(a, b) { return a - b }, 2); g := f(10); out = [g(1), g(2), f(5)(5)]`, ARR{9, 8, 0})
+	expect(t, `out = curry(append, 3)([])(1)(2)`, ARR{1, 2})
+
+	expect(t, `out = is_error(curry(func() {}, 0))`, true)
+	expect(t, `out = is_error(curry(func() {}, -1))`, true)
+
+	expectError(t, `curry(func(a, b) { return a }, 3)(1)(2)(3)`)
+	expectError(t, `curry(func(a, b) { return a }, 2)(1, 2)`)
+	expectError(t, `curry(func(a, b) { return a }, 2)()`)
+	expectError(t, `curry(1, 2)`)
+	expectError(t, `curry(func(a) {}, "1")`)
+	expectError(t, `curry(func(a) {})`)
+}
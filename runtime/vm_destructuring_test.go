@@ -0,0 +1,76 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestDestructuring(t *testing.T) {
+	// arrays
+	expect(t, `a, b := [1, 2]; out = a + b`, 3)
+	expect(t, `a, b, c := [1, 2, 3]; out = [c, b, a]`, ARR{3, 2, 1})
+	expect(t, `a, b := immutable([1, 2]); out = [a, b]`, ARR{1, 2})
+	expect(t, `a, b := [1]; out = [a, b]`, ARR{1, objects.UndefinedValue})
+	expect(t, `a, b := [1, 2, 3]; out = [a, b]`, ARR{1, 2})
+	expect(t, `a := 0; b := 0; a, b = [5, 6]; out = [a, b]`, ARR{5, 6})
+	expect(t, `a := 1; b := 2; a, b = [b, a]; out = [a, b]`, ARR{2, 1})
+	expect(t, `m := {}; arr := [0, 0]; m.x, arr[1] = [3, 4]; out = [m.x, arr]`, ARR{3, ARR{0, 4}})
+	expect(t, `out = func(p) { a, b := p; return a * b }([3, 4])`, 12)
+	expect(t, `a := 1; out = func() { b, c := [a, 2]; return b + c }()`, 3)
+
+	// rest
+	expect(t, `a, b... := [1, 2, 3]; out = [a, b]`, ARR{1, ARR{2, 3}})
+	expect(t, `a, b... := [1]; out = b`, ARR{})
+	expect(t, `a, b, c... := [1]; out = [a, b, c]`, ARR{1, objects.UndefinedValue, ARR{}})
+	expect(t, `a... := [1, 2]; out = a`, ARR{1, 2})
+	expect(t, `x := [1, 2, 3]; a, b... := x; b[0] = 9; out = x`, ARR{1, 2, 3})
+
+	// maps
+	expect(t, `{a, b} := {a: 1, b: 2, c: 3}; out = a + b`, 3)
+	expect(t, `{a: x, b: y} := {a: 1, b: 2}; out = [x, y]`, ARR{1, 2})
+	expect(t, `{a, b: y} := immutable({a: 1, b: 2}); out = [a, y]`, ARR{1, 2})
+	expect(t, `{a, b} := {a: 1}; out = b`, objects.UndefinedValue)
+	expect(t, `a := 0; {a} = {a: 5}; out = a`, 5)
+	expect(t, `m := {}; {a: m.x} = {a: 7}; out = m`, MAP{"x": 7})
+
+	expectError(t, `a, b := 1`)              // not an array
+	expectError(t, `{a} := [1]`)             // not a map
+	expectError(t, `a := 1; a, b := [1, 2]`) // redeclared
+	expectError(t, `a, b = [1, 2]`)          // unresolved
+	expectError(t, `{a}, b := [{a: 1}, 2]`)  // mixed
+	expectError(t, `a, m.x := [1, 2]`)       // selector with ':='
+	expectError(t, `1, b := [1, 2]`)         // invalid target
+	expectError(t, `out = {a}`)              // shorthand outside of destructuring
+}
+
+func TestBlankIdentifier(t *testing.T) {
+	// destructuring
+	expect(t, `_, b := [1, 2]; out = b`, 2)
+	expect(t, `a, _, c := [1, 2, 3]; out = [a, c]`, ARR{1, 3})
+	expect(t, `a := 0; _, a = [1, 2]; out = a`, 2)
+	expect(t, `_, _ := [1, 2]; out = 1`, 1)
+	expect(t, `_, b... := [1, 2, 3]; out = b`, ARR{2, 3})
+	expect(t, `{a, b: _} := {a: 1, b: 2}; out = a`, 1)
+	expect(t, `out = func() { _, b := [1, 2]; return b }()`, 2)
+	expect(t, `_, b := [1, 2]; _, c := [3, 4]; out = b + c`, 6)
+
+	// assignment
+	expect(t, `_ := 1; out = 2`, 2)
+	expect(t, `_ = [1, 2]; _ = 3; out = 2`, 2)
+	expect(t, `a := 0; f := func() { a = 5; return 1 }; _ = f(); out = a`, 5)
+
+	// loops
+	expect(t, `for _, v in [1, 2, 3] { out += v }`, 6)
+	expect(t, `for _, _ in [1, 2, 3] { out += 1 }`, 3)
+
+	// select
+	expect(t, "ch := channel(1); ch.send(1)\nselect {\ncase _ := <-ch:\n\tout = ch.len() + 1\n}", 1)
+
+	// '_' cannot be used as a value
+	expectError(t, `_ := 1; out = _`)
+	expectError(t, `_, b := [1, 2]; out = _`)
+	expectError(t, `for _, v in [1] { out = _ }`)
+	expectError(t, `_ += 1`)
+	expectError(t, `out = [_]`)
+}
@@ -0,0 +1,18 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestDict(t *testing.T) {
+	expect(t, `out = dict()`, MAP{})
+	expect(t, `out = dict("a", 1)`, MAP{"a": 1})
+	expect(t, `k := "b"; out = dict("a", 1, k, [2], "c", {d: true})`, MAP{"a": 1, "b": ARR{2}, "c": MAP{"d": true}})
+	expect(t, `out = dict("a", 1, "a", 2)`, MAP{"a": 2})
+	expect(t, `m := dict("a", 1); m.b = 2; out = m`, MAP{"a": 1, "b": 2})
+
+	expectError(t, `dict("a")`)
+	expectError(t, `dict("a", 1, "b")`)
+	expectError(t, `dict(1, 2)`)
+	expectError(t, `dict("a", 1, 'b', 2)`)
+}
@@ -0,0 +1,36 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	expect(t, `out = diff({a: 1}, {a: 1})`, MAP{"added": ARR{}, "removed": ARR{}, "changed": MAP{}})
+	expect(t, `out = diff({}, {b: 1, a: 2}).added`, ARR{"a", "b"})
+	expect(t, `out = diff({b: 1, a: 2, c: 3}, {c: 3}).removed`, ARR{"a", "b"})
+	expect(t, `out = diff({a: 1, b: 2}, {a: 1, b: "2"}).changed`, MAP{"b": ARR{2, "2"}})
+	expect(t, `out = diff({a: 1, b: 2}, {b: 3, c: 4})`, MAP{
+		"added":   ARR{"c"},
+		"removed": ARR{"a"},
+		"changed": MAP{"b": ARR{2, 3}},
+	})
+
+	// deep equality
+	expect(t, `out = diff({a: [1, {b: 2}]}, {a: [1, {b: 2}]}).changed`, MAP{})
+	expect(t, `out = diff({a: {x: 1}}, {a: {x: 2}}).changed`, MAP{"a": ARR{MAP{"x": 1}, MAP{"x": 2}}})
+
+	// recursive
+	expect(t, `out = diff({a: {x: 1, y: 1}}, {a: {x: 2, z: 1}}, true).changed`, MAP{"a": MAP{
+		"added":   ARR{"z"},
+		"removed": ARR{"y"},
+		"changed": MAP{"x": ARR{1, 2}},
+	}})
+	expect(t, `out = diff({a: {x: 1}}, {a: 1}, true).changed`, MAP{"a": ARR{MAP{"x": 1}, 1}})
+
+	expect(t, `out = diff(immutable({a: 1}), readonly({})).removed`, ARR{"a"})
+
+	expectError(t, `diff({}, [])`)
+	expectError(t, `diff(1, {})`)
+	expectError(t, `diff({}, {}, 1)`)
+	expectError(t, `diff({})`)
+}
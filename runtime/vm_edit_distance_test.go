@@ -0,0 +1,25 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	expect(t, `out = edit_distance("abc", "abc")`, 0)
+	expect(t, `out = edit_distance("", "")`, 0)
+	expect(t, `out = edit_distance("abc", "abxc")`, 1)
+	expect(t, `out = edit_distance("abc", "")`, 3)
+	expect(t, `out = edit_distance("", "abc")`, 3)
+	expect(t, `out = edit_distance("kitten", "sitting")`, 3)
+	expect(t, `out = edit_distance("sitting", "kitten")`, 3)
+	expect(t, `out = edit_distance("flaw", "lawn")`, 2)
+
+	// multi-byte characters count as one
+	expect(t, `out = edit_distance("café", "cafe")`, 1)
+	expect(t, `out = edit_distance("世界", "世")`, 1)
+	expect(t, `out = edit_distance("한국어", "한글")`, 2)
+
+	expectError(t, `edit_distance("a")`)
+	expectError(t, `edit_distance("a", 1)`)
+	expectError(t, `edit_distance(['a'], "a")`)
+}
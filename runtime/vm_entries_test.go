@@ -0,0 +1,29 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestEntries(t *testing.T) {
+	expect(t, `out = entries({b: 2, a: 1, c: [3]})`, ARR{ARR{"a", 1}, ARR{"b", 2}, ARR{"c", ARR{3}}})
+	expect(t, `out = entries(immutable({x: true}))`, ARR{ARR{"x", true}})
+	expect(t, `out = entries({})`, ARR{})
+	expect(t, `m := ordered_map(); m.b = 1; m.a = 2; out = entries(m)`, ARR{ARR{"b", 1}, ARR{"a", 2}})
+
+	expect(t, `out = from_entries([["a", 1], ["b", 2]])`, MAP{"a": 1, "b": 2})
+	expect(t, `out = from_entries([[1, "x"], ["a", 1], ["a", 2]])`, MAP{"1": "x", "a": 2})
+	expect(t, `out = from_entries(immutable([immutable(["a", 1])]))`, MAP{"a": 1})
+	expect(t, `out = from_entries([])`, MAP{})
+
+	// round-trip
+	expect(t, `m := {a: 1, b: "x", c: {d: [1, 2]}}; out = from_entries(entries(m)) == m`, true)
+	expect(t, `out = from_entries(entries({a: 1, b: 2}))`, MAP{"a": 1, "b": 2})
+
+	expectError(t, `entries([1, 2])`)
+	expectError(t, `entries()`)
+	expectError(t, `from_entries({a: 1})`)
+	expectError(t, `from_entries([["a"]])`)
+	expectError(t, `from_entries([["a", 1, 2]])`)
+	expectError(t, `from_entries([1])`)
+	expectError(t, `from_entries([[undefined, 1]])`)
+}
@@ -0,0 +1,95 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestWrapError(t *testing.T) {
+	// wrapping
+	expect(t, `out = wrap_error(error("not found"), "loading")`, errorObject("loading: not found"))
+	expect(t, `out = wrap_error(error(404), "request")`, errorObject("request: 404"))
+	expect(t, `out = wrap_error(wrap_error(error("a"), "b"), "c")`, errorObject("c: b: a"))
+	expect(t, `out = is_error(wrap_error(error("a"), "b"))`, true)
+
+	// retrieving the cause
+	expect(t, `e := error("a"); out = error_cause(wrap_error(e, "b")) == e`, true)
+	expect(t, `out = error_cause(wrap_error(error("a"), "b"))`, errorObject("a"))
+	expect(t, `e := wrap_error(wrap_error(error("a"), "b"), "c"); out = error_cause(error_cause(e))`, errorObject("a"))
+	expect(t, `e := wrap_error(error("a"), "b"); out = error_cause(copy(e))`, errorObject("a"))
+
+	// non-wrapped errors
+	expect(t, `out = error_cause(error("a"))`, objects.UndefinedValue)
+	expect(t, `out = error_cause(error_cause(wrap_error(error("a"), "b")))`, objects.UndefinedValue)
+
+	expectError(t, `wrap_error("a", "b")`)
+	expectError(t, `wrap_error(error("a"), 1)`)
+	expectError(t, `wrap_error(error("a"))`)
+	expectError(t, `error_cause("a")`)
+	expectError(t, `error_cause()`)
+}
+
+func TestErrorFields(t *testing.T) {
+	// attaching fields
+	expect(t, `out = error_with(error("failed"), {status: 503})`, errorObject("failed"))
+	expect(t, `out = error_fields(error_with(error("failed"), {status: 503, url: "/api"}))`, MAP{"status": 503, "url": "/api"})
+	expect(t, `out = error_fields(error_with(error("a"), immutable({k: "v"})))`, MAP{"k": "v"})
+	expect(t, `e := error_with(error_with(error("a"), {x: 1, y: 2}), {y: 3}); out = error_fields(e)`, MAP{"x": 1, "y": 3})
+
+	// the original error is not changed
+	expect(t, `e := error("a"); error_with(e, {x: 1}); out = error_fields(e)`, MAP{})
+	expect(t, `e := error_with(error("a"), {x: 1}); f := error_fields(e); f.x = 2; out = error_fields(e)`, MAP{"x": 1})
+
+	// the message and the cause are kept
+	expect(t, `e := error_with(wrap_error(error("a"), "b"), {x: 1}); out = [e, error_cause(e)]`, ARR{errorObject("b: a"), errorObject("a")})
+	expect(t, `e := wrap_error(error_with(error("a"), {x: 1}), "b"); out = [error_fields(e), error_fields(error_cause(e))]`, ARR{MAP{}, MAP{"x": 1}})
+	expect(t, `out = error_fields(copy(error_with(error("a"), {x: 1})))`, MAP{"x": 1})
+
+	expectError(t, `error_with("a", {})`)
+	expectError(t, `error_with(error("a"), [1])`)
+	expectError(t, `error_with(error("a"))`)
+	expectError(t, `error_fields({})`)
+}
+
+func TestErrorKind(t *testing.T) {
+	expect(t, `out = error("not found", "io")`, errorObject("not found"))
+	expect(t, `out = error_kind(error("not found", "io"))`, "io")
+	expect(t, `k := "net"; out = error_kind(error("timeout", k + "/dial"))`, "net/dial")
+	expect(t, `e1 := error("a", "io"); e2 := error("a", "auth"); out = [error_kind(e1), error_kind(e2), e1.value == e2.value]`, ARR{"io", "auth", true})
+	expect(t, `out = error_kind(error("a"))`, objects.UndefinedValue)
+	expect(t, `out = error_kind(error("a", ""))`, objects.UndefinedValue)
+
+	// branching on the kind
+	expect(t, `
+f := func(e) {
+	k := error_kind(e)
+	if k == "io" { return 1 } else if k == "auth" { return 2 }
+	return 0
+}
+out = [f(error("x", "io")), f(error("x", "auth")), f(error("x"))]`, ARR{1, 2, 0})
+
+	// the kind is kept by copy, error_with and wrap_error
+	expect(t, `out = error_kind(copy(error("a", "io")))`, "io")
+	expect(t, `out = error_kind(error_with(error("a", "io"), {x: 1}))`, "io")
+	expect(t, `out = error_kind(wrap_error(error("a", "io"), "b"))`, "io")
+
+	expectError(t, `error("a", 1)`)
+	expectError(t, `error_kind("a")`)
+	expectError(t, `error_kind()`)
+}
+
+func TestCollectErrors(t *testing.T) {
+	expect(t, `out = collect_errors([1, error("a"), "b", error("c"), undefined])`, ARR{errorObject("a"), errorObject("c")})
+	expect(t, `out = len(collect_errors(immutable([error(1), [error(2)], error(3)])))`, 2)
+	expect(t, `out = error_kind(collect_errors([1, error("a", "io")])[0])`, "io")
+	expect(t, `out = collect_errors(times(3, func(i) { return i == 1 ? error(i) : i }))`, ARR{errorObject(1)})
+
+	// no errors
+	expect(t, `out = collect_errors([1, "a", [], {}])`, ARR{})
+	expect(t, `out = collect_errors([])`, ARR{})
+
+	expectError(t, `collect_errors(error("a"))`)
+	expectError(t, `collect_errors({})`)
+	expectError(t, `collect_errors()`)
+}
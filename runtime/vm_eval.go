@@ -0,0 +1,127 @@
+package runtime
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/d5/tengo/compiler"
+	"github.com/d5/tengo/compiler/ast"
+	"github.com/d5/tengo/compiler/parser"
+	"github.com/d5/tengo/compiler/source"
+	"github.com/d5/tengo/compiler/token"
+	"github.com/d5/tengo/objects"
+)
+
+// evalResult is the name of the hidden global variable that stores
+// the value of the last expression statement of the evaluated source.
+const evalResult = ":result"
+
+// evalDisabledBuiltins are the builtin functions that are never available
+// to the evaluated source.
+var evalDisabledBuiltins = map[string]bool{
+	"go":   true,
+	"eval": true,
+}
+
+// SetEvalLimits sets the maximum number of constants and instructions
+// of the source compiled by 'eval' builtin function. Zero or negative value
+// means no limit.
+func (v *VM) SetEvalLimits(maxConstants, maxInstructions int) {
+	v.evalMaxConstants = maxConstants
+	v.evalMaxInstructions = maxInstructions
+}
+
+// DisableEvalBuiltins disables the builtin functions for the source compiled
+// by 'eval' builtin function. 'go' and 'eval' are always disabled.
+func (v *VM) DisableEvalBuiltins(names ...string) {
+	if v.evalDisabledBuiltins == nil {
+		v.evalDisabledBuiltins = make(map[string]bool)
+	}
+
+	for _, name := range names {
+		v.evalDisabledBuiltins[name] = true
+	}
+}
+
+// Eval compiles and runs the source in a new VM and returns the value
+// of the last expression statement. It returns undefined if the source
+// does not end with an expression. The source can use the builtin functions
+// except 'go', 'eval' and the functions disabled by DisableEvalBuiltins. It
+// cannot access the global variables of v or import modules. The new VM is
+// aborted along with v. If the source cannot be compiled, Eval returns an
+// error object.
+func (v *VM) Eval(src string) (objects.Object, error) {
+	root := v
+	for root.parent != nil {
+		root = root.parent
+	}
+
+	bytecode, err := compileEval([]byte(src), root.evalDisabledBuiltins, root.evalMaxConstants, root.evalMaxInstructions)
+	if err != nil {
+		return &objects.Error{Value: &objects.String{Value: err.Error()}}, nil
+	}
+
+	machine := NewVM(bytecode, nil)
+	machine.aborting = v.aborting
+	machine.parent = v
+	machine.opcodeCounts = v.opcodeCounts
+	machine.coverage = v.coverage
+
+	if err := machine.run(); err != nil {
+		return nil, err
+	}
+
+	if atomic.LoadInt64(machine.aborting) != 0 {
+		return nil, objects.ErrAborted
+	}
+
+	if res := machine.globals[0]; res != nil {
+		return *res, nil
+	}
+
+	return objects.UndefinedValue, nil
+}
+
+func compileEval(src []byte, disabledBuiltins map[string]bool, maxConstants, maxInstructions int) (*compiler.Bytecode, error) {
+	fileSet := source.NewFileSet()
+
+	p := parser.NewParser(fileSet.AddFile("(eval)", -1, len(src)), src, nil)
+	file, err := p.ParseFile()
+	if err != nil {
+		return nil, err
+	}
+
+	symbolTable := compiler.NewSymbolTable()
+	for idx, fn := range objects.Builtins {
+		if !evalDisabledBuiltins[fn.Name] && !disabledBuiltins[fn.Name] {
+			symbolTable.DefineBuiltin(idx, fn.Name)
+		}
+	}
+
+	// the result is stored in the first global variable
+	symbolTable.Define(evalResult)
+
+	if n := len(file.Stmts); n > 0 {
+		if stmt, ok := file.Stmts[n-1].(*ast.ExprStmt); ok {
+			file.Stmts[n-1] = &ast.AssignStmt{
+				LHS:      []ast.Expr{&ast.Ident{Name: evalResult, NamePos: stmt.Pos()}},
+				RHS:      []ast.Expr{stmt.Expr},
+				Token:    token.Assign,
+				TokenPos: stmt.Pos(),
+			}
+		}
+	}
+
+	c := compiler.NewCompiler(symbolTable, map[string]*objects.ImmutableMap{}, nil)
+	c.SetModuleLoader(func(moduleName string) ([]byte, error) {
+		return nil, fmt.Errorf("module '%s' cannot be imported in eval", moduleName)
+	})
+	c.SetMaxConstants(maxConstants)
+	c.SetMaxInstructions(maxInstructions)
+
+	if err := c.Compile(file); err != nil {
+		return nil, err
+	}
+
+	return c.Bytecode(), nil
+}
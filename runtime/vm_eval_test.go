@@ -0,0 +1,34 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestEval(t *testing.T) {
+	expect(t, `out = eval("1 + 2 * 3")`, 7)
+	expect(t, `out = eval("x := [1, 2]; x[1] * 10")`, 20)
+	expect(t, `out = eval("f := func(n) { return n * n }; f(4) + len(\"abc\")")`, 19)
+	expect(t, `out = eval("{a: 1}")`, MAP{"a": 1})
+	expect(t, `out = eval("a := 1")`, objects.UndefinedValue)
+	expect(t, `out = eval("")`, objects.UndefinedValue)
+	expect(t, `s := "1 + "; out = eval(s + "2")`, 3)
+	expect(t, `f := func() { return eval("2 * 3") }; out = f()`, 6)
+
+	// isolation from the caller
+	expect(t, `a := 5; out = is_error(eval("a"))`, true)
+	expect(t, `a := 5; eval("a := 10"); out = a`, 5)
+	expect(t, `out = eval("out := 3; out") + 1`, 4)
+
+	// compile errors
+	expect(t, `out = eval("1 +")`, errorObject("(eval):1:4: expected operand, found 'EOF'"))
+	expect(t, `out = is_error(eval("import(\"math\")"))`, true)
+	expect(t, `out = is_error(eval("go(func() {})"))`, true)
+	expect(t, `out = is_error(eval("eval(\"1\")"))`, true)
+
+	// runtime errors
+	expectError(t, `eval("1 / 0")`)
+	expectError(t, `eval(1)`)
+	expectError(t, `eval()`)
+}
@@ -0,0 +1,29 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestExpand(t *testing.T) {
+	expect(t, `out = expand("Hello, $name!", {name: "Tengo"})`, "Hello, Tengo!")
+	expect(t, `out = expand("${a}b$c", {a: 1, c: [1, 2]})`, "1b[1, 2]")
+	expect(t, `out = expand("$a_1.$a", immutable({a_1: "x", a: 'y'}))`, "x.y")
+	expect(t, `m := ordered_map(); m.k = "v"; out = expand("<$k>", m)`, "<v>")
+	expect(t, `out = expand("no tokens", {})`, "no tokens")
+
+	// missing keys
+	expect(t, `out = expand("$missing and ${missing}", {})`, "$missing and ${missing}")
+	expect(t, `out = expand("$a", {a: undefined})`, "$a")
+	expect(t, `out = expand("${a", {a: 1})`, "${a")
+	expect(t, `out = expand("${}", {})`, "${}")
+	expect(t, `out = expand("$ $", {})`, "$ $")
+
+	// escape
+	expect(t, `out = expand("$$", {})`, "$")
+	expect(t, `out = expand("costs $$$price", {price: 5})`, "costs $5")
+	expect(t, `out = expand("$$name", {name: "x"})`, "$name")
+
+	expectError(t, `expand("$a")`)
+	expectError(t, `expand(1, {})`)
+	expectError(t, `expand("$a", [1])`)
+}
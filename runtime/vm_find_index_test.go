@@ -0,0 +1,28 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestFindIndex(t *testing.T) {
+	expect(t, `out = find_index([1, 2, 3, 4], func(x) { return x % 2 == 0 })`, 1)
+	expect(t, `out = find_index([1, 2, 3, 4], func(x) { return x > 5 })`, -1)
+	expect(t, `out = find_index(["a", 1, "b"], is_int)`, 1)
+	expect(t, `out = find_index([], func(x) { return true })`, -1)
+	expect(t, `out = find_index(immutable([5, 6]), func(x) { return x == 6 })`, 1)
+	expect(t, `out = find_index([{id: 1}, {id: 2}], func(x) { return x.id == 2 })`, 1)
+
+	expect(t, `out = find_last_index([1, 2, 3, 4], func(x) { return x % 2 == 0 })`, 3)
+	expect(t, `out = find_last_index([1, 2, 3, 4], func(x) { return x > 5 })`, -1)
+	expect(t, `out = find_last_index([2, 1, 1], func(x) { return x == 2 })`, 0)
+	expect(t, `out = find_last_index([], func(x) { return true })`, -1)
+
+	// stops at the first match
+	expect(t, `n := 0; find_index([1, 2, 3], func(x) { n++; return x == 2 }); out = n`, 2)
+	expect(t, `n := 0; find_last_index([1, 2, 3], func(x) { n++; return x == 2 }); out = n`, 2)
+
+	expectError(t, `find_index([1, 2], func(x) { return x + "a" })`)
+	expectError(t, `find_last_index([1, 2], func(x) { return x + "a" })`)
+	expectError(t, `find_index("abc", func(x) { return true })`)
+	expectError(t, `find_index([1, 2])`)
+}
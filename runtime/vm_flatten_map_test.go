@@ -0,0 +1,29 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestFlattenMap(t *testing.T) {
+	expect(t, `out = flatten_map({})`, MAP{})
+	expect(t, `out = flatten_map({a: 1})`, MAP{"a": 1})
+	expect(t, `out = flatten_map({a: {b: {c: 1}, d: 2}, e: 3})`, MAP{"a.b.c": 1, "a.d": 2, "e": 3})
+	expect(t, `out = flatten_map({a: [1, {b: 2}], c: {}})`, MAP{"a": ARR{1, MAP{"b": 2}}, "c": MAP{}})
+	expect(t, `out = flatten_map(immutable({a: {b: 1}}))`, MAP{"a.b": 1})
+	expect(t, `out = flatten_map(dict("a.b", 1))`, MAP{"a.b": 1})
+	expectError(t, `flatten_map(dict("a.b", 1, "a", {b: 2}))`)
+	expectError(t, `flatten_map([1])`)
+
+	expect(t, `out = unflatten_map(dict("a.b.c", 1, "a.d", 2, "e", 3))`, MAP{"a": MAP{"b": MAP{"c": 1}, "d": 2}, "e": 3})
+	expect(t, `out = unflatten_map({})`, MAP{})
+	expect(t, `out = unflatten_map({a: {}})`, MAP{"a": MAP{}})
+	expectError(t, `unflatten_map(dict("a", 1, "a.b", 2))`)
+	expectError(t, `unflatten_map(dict("a", {}, "a.b", 2))`)
+	expectError(t, `unflatten_map(1)`)
+
+	// round trip
+	expect(t, `m := {a: {b: {c: 1}, d: [1, 2]}, e: "x", f: {}}; out = unflatten_map(flatten_map(m)) == m`, true)
+
+	// a key that contains a dot is split
+	expect(t, `out = unflatten_map(flatten_map(dict("a.b", 1)))`, MAP{"a": MAP{"b": 1}})
+}
@@ -0,0 +1,49 @@
+package runtime_test
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloatChecks(t *testing.T) {
+	expect(t, `out = inf()`, math.Inf(1))
+	expect(t, `out = inf(1)`, math.Inf(1))
+	expect(t, `out = inf(0)`, math.Inf(1))
+	expect(t, `out = inf(-1)`, math.Inf(-1))
+	expect(t, `out = nan() == nan()`, false)
+
+	// NaN
+	expect(t, `out = is_nan(nan())`, true)
+	expect(t, `out = is_inf(nan())`, false)
+	expect(t, `out = is_finite(nan())`, false)
+	expect(t, `out = is_nan(inf() - inf())`, true)
+
+	// +Inf
+	expect(t, `out = is_nan(inf())`, false)
+	expect(t, `out = is_inf(inf())`, true)
+	expect(t, `out = is_inf(inf(), 1)`, true)
+	expect(t, `out = is_inf(inf(), -1)`, false)
+	expect(t, `out = is_finite(inf())`, false)
+	expect(t, `out = is_inf(1e308 * 10.0)`, true)
+
+	// -Inf
+	expect(t, `out = is_inf(inf(-1))`, true)
+	expect(t, `out = is_inf(inf(-1), 1)`, false)
+	expect(t, `out = is_inf(inf(-1), -1)`, true)
+	expect(t, `out = is_finite(inf(-1))`, false)
+
+	// finite
+	expect(t, `out = is_nan(1.5)`, false)
+	expect(t, `out = is_inf(1.5)`, false)
+	expect(t, `out = is_finite(1.5)`, true)
+	expect(t, `out = is_finite(0.0)`, true)
+	expect(t, `out = [is_nan(1), is_inf(1), is_finite(1)]`, ARR{false, false, true})
+
+	expectError(t, `is_nan("a")`)
+	expectError(t, `is_inf(1.0, "a")`)
+	expectError(t, `is_inf(1.0, 1, 2)`)
+	expectError(t, `is_finite()`)
+	expectError(t, `nan(1)`)
+	expectError(t, `inf(1.0)`)
+	expectError(t, `inf(1, 2)`)
+}
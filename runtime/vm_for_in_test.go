@@ -22,4 +22,11 @@ func TestForIn(t *testing.T) {
 	// string
 	expect(t, `for c in "abcde" { out += c }`, "abcde")
 	expect(t, `for i, c in "abcde" { if i == 2 { continue }; out += c }`, "abde")
+
+	// bytes
+	expect(t, `for b in bytes("abc") { out += b }`, 294)                                          // value
+	expect(t, `out = []; for i, b in bytes("ab") { out = append(out, i, b) }`, ARR{0, 97, 1, 98}) // index, value
+	expect(t, `for i, _ in bytes("abc") { out += i }`, 3)                                         // index, _
+	expect(t, `out = 0; for b in bytes(0) { out = b }`, 0)                                        // empty
+	expect(t, `for b in bytes("\xff") { out = b }`, 255)
 }
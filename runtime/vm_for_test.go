@@ -5,6 +5,14 @@ import (
 )
 
 func TestFor(t *testing.T) {
+	expect(t, `
+	for {
+		out++
+		if out == 5 {
+			break
+		}
+	}`, 5)
+
 	expect(t, `
 	for true {
 		out++
@@ -0,0 +1,62 @@
+package runtime
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/d5/tengo/objects"
+)
+
+// forkGroup is shared by the VMs forked during a run. It is aborted when
+// the run ends, so the forked VMs do not outlive the run.
+type forkGroup struct {
+	aborting int64
+	doneLock sync.Mutex
+	done     chan struct{}
+}
+
+func newForkGroup() *forkGroup {
+	return &forkGroup{done: make(chan struct{})}
+}
+
+func (g *forkGroup) abort() {
+	atomic.StoreInt64(&g.aborting, 1)
+
+	g.doneLock.Lock()
+	select {
+	case <-g.done: // already closed
+	default:
+		close(g.done)
+	}
+	g.doneLock.Unlock()
+}
+
+// Fork returns a child VM that has a copy of the global variables of v.
+// Functions can be called on the returned VM concurrently with v. The
+// global variables are copied using objects.ForkCopy, so the child VM does
+// not share the mutable objects (e.g. maps and arrays) with v. The child
+// VM is aborted when the run of v ends or v is aborted.
+func (v *VM) Fork() objects.Interop {
+	root := v
+	for root.parent != nil {
+		root = root.parent
+	}
+
+	root.doneLock.Lock()
+	group := root.forks
+	root.doneLock.Unlock()
+
+	globals := make([]*objects.Object, len(v.globals))
+	for i, g := range v.globals {
+		if g != nil {
+			c := objects.ForkCopy(*g)
+			globals[i] = &c
+		}
+	}
+
+	child := v.newChild(globals)
+	child.aborting = &group.aborting
+	child.group = group
+
+	return child
+}
@@ -0,0 +1,36 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestFormatFloat(t *testing.T) {
+	// fixed
+	expect(t, `out = format_float(3.14159, 'f', 2)`, "3.14")
+	expect(t, `out = format_float(3.14159, "f", 0)`, "3")
+	expect(t, `out = format_float(2.5, 'f', 3)`, "2.500")
+	expect(t, `out = format_float(1.0, 'f', -1)`, "1")
+	expect(t, `out = format_float(-0.125, 'f', -1)`, "-0.125")
+	expect(t, `out = format_float(10, 'f', 1)`, "10.0")
+
+	// exponential
+	expect(t, `out = format_float(1234.5678, 'e', 3)`, "1.235e+03")
+	expect(t, `out = format_float(1234.5678, 'E', 2)`, "1.23E+03")
+	expect(t, `out = format_float(0.0001, 'e', -1)`, "1e-04")
+
+	// shortest
+	expect(t, `out = format_float(0.000012, 'g', -1)`, "1.2e-05")
+	expect(t, `out = format_float(123.456, 'g', -1)`, "123.456")
+	expect(t, `out = format_float(123.456, 'g', 4)`, "123.5")
+	expect(t, `out = format_float(1e21, 'G', -1)`, "1E+21")
+
+	expect(t, `out = string(1e21)`, "1000000000000000000000")
+
+	expectError(t, `format_float(1.0, 'x', 2)`)
+	expectError(t, `format_float(1.0, "ff", 2)`)
+	expectError(t, `format_float(1.0, "", 2)`)
+	expectError(t, `format_float(1.0, 1, 2)`)
+	expectError(t, `format_float("1.0", 'f', 2)`)
+	expectError(t, `format_float(1.0, 'f', 2.0)`)
+	expectError(t, `format_float(1.0, 'f')`)
+}
@@ -0,0 +1,33 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestGetIn(t *testing.T) {
+	// present paths
+	expect(t, `out = get_in({a: {b: [1, 2, 3]}}, ["a", "b", 1], 0)`, 2)
+	expect(t, `out = get_in([{a: 1}], [0, "a"], 0)`, 1)
+	expect(t, `out = get_in(immutable({a: immutable([5])}), ["a", 0], 0)`, 5)
+	expect(t, `out = get_in({a: 1}, [], 0)`, MAP{"a": 1})
+	expect(t, `out = get_in({a: undefined}, ["a"], 0)`, objects.UndefinedValue)
+
+	// missing segments
+	expect(t, `out = get_in({a: {b: 1}}, ["a", "x", "b"], 0)`, 0)
+	expect(t, `out = get_in({a: {b: 1}}, ["x"], "d")`, "d")
+	expect(t, `out = get_in([1, 2], [2], 0)`, 0)
+	expect(t, `out = get_in([1, 2], [-1], 0)`, 0)
+	expect(t, `out = get_in({a: 1}, ["b"])`, objects.UndefinedValue)
+
+	// type mismatches
+	expect(t, `out = get_in({a: [1]}, ["a", "b"], 0)`, 0)
+	expect(t, `out = get_in({a: {b: 1}}, ["a", 0], 0)`, 0)
+	expect(t, `out = get_in({a: 1}, ["a", "b"], 0)`, 0)
+	expect(t, `out = get_in(1, ["a"], 0)`, 0)
+
+	expectError(t, `get_in({})`)
+	expectError(t, `get_in({}, ["a"], 0, 1)`)
+	expectError(t, `get_in({}, "a", 0)`)
+}
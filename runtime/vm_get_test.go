@@ -0,0 +1,23 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestGet(t *testing.T) {
+	expect(t, `out = get({a: 1, b: 2}, "a", 0)`, 1)
+	expect(t, `out = get(immutable({a: [1]}), "a", 0)`, ARR{1})
+	expect(t, `out = get({a: 1}, "b", 0)`, 0)
+	expect(t, `out = get({}, "b", "x")`, "x")
+	expect(t, `out = get({a: 1}, "b")`, objects.UndefinedValue)
+	expect(t, `out = get({a: 1}, "b", undefined)`, objects.UndefinedValue)
+	expect(t, `out = get({a: undefined}, "a", 0)`, objects.UndefinedValue)
+	expect(t, `m := {a: {}}; v := get(m, "a", 0); v.x = 1; out = m`, MAP{"a": MAP{"x": 1}})
+
+	expectError(t, `get({a: 1})`)
+	expectError(t, `get({a: 1}, "a", 0, 1)`)
+	expectError(t, `get([1], 0, 0)`)
+	expectError(t, `get({a: 1}, 1, 0)`)
+}
@@ -0,0 +1,63 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestGo(t *testing.T) {
+	expect(t, `t := go(func(a, b) { return a * b }, 3, 4); out = t.await()`, 12)
+	expect(t, `t1 := go(func() { return 1 }); t2 := go(func() { return 2 }); out = [t1.await(), t2.await()]`, ARR{1, 2})
+	expect(t, `t := go(func() {}); out = t.await()`, objects.UndefinedValue)
+	expect(t, `t := go(len, [1, 2]); out = t.await()`, 2)
+	expect(t, `t := go(func() { return 5 }); t.await(); out = t.await()`, 5)
+
+	// globals and free variables
+	expect(t, `x := 10; t := go(func() { return x + 1 }); out = t.await()`, 11)
+	expect(t, `out = func() { y := 3; return go(func() { return y * 2 }).await() }()`, 6)
+	expect(t, `x := 1; t := go(func() { x = 2 }); t.await(); out = x`, 1) // goroutine has a copy of the globals
+
+	// goroutines do not share the maps and arrays with the caller
+	expect(t, `m := {}; go(func() { m.a = 1 }).await(); out = len(m)`, 0)
+	expect(t, `a := [1]; go(func(x) { x[0] = 2 }, a).await(); out = a[0]`, 1)
+	expect(t, `out = func() { m := {a: 1}; go(func() { m.a = 2 }).await(); return m.a }()`, 1)
+	expect(t, `ch := channel(1); m := {a: 1}; ch.send(m); r := ch.receive(); r.a = 2; out = m.a`, 1)
+	expect(t, `
+m := {}
+t := go(func() { for i := 0; i < 1000; i++ { m[string(i)] = i } })
+for i := 0; i < 1000; i++ { m[string(i)] = i }
+t.await()
+out = len(m)`, 1000)
+
+	// lazy iterators cannot be shared with the goroutines
+	expectError(t, `it := lazy_map(range(0, 200), func(x) { return x }); go(func(i) { return collect(i) }, it).await()`)
+	expectError(t, `it := range(0, 3); go(func(a) { for x in a[0] {} }, [it]).await()`)
+	expectError(t, `it := range(0, 3); go(func() { return collect(it) }).await()`)
+	expect(t, `it := lazy_map(range(0, 3), func(x) { return x * 2 }); go(func() {}).await(); out = collect(it)`, ARR{0, 2, 4})
+	expect(t, `out = go(func() { return collect(lazy_filter(range(0, 5), func(x) { return x % 2 })) }).await()`, ARR{1, 3})
+
+	// read-only views are shared without copying the map
+	expect(t, `m := {a: 1}; out = go(func(x) { return [type_name(x), x.a] }, readonly(m)).await()`, ARR{"readonly-map", 1})
+	expect(t, `m := {a: 1}; v := readonly(m); out = go(func(x) { return same(x[0], v) }, [v]).await()`, true)
+	expect(t, `m := {a: 1}; ch := channel(1); ch.send(readonly(m)); out = type_name(ch.receive())`, "readonly-map")
+	expectError(t, `m := {a: 1}; go(func(x) { x.a = 5 }, readonly(m)).await()`)
+
+	// immutable containers stay immutable
+	expectError(t, `go(func(a) { a[0] = 2 }, immutable([1])).await()`)
+
+	// goroutines communicating using a channel
+	expect(t, `
+ch := channel()
+t := go(func() { s := 0; for i := 0; i < 3; i++ { s += ch.receive() }; return s })
+for i := 1; i <= 3; i++ { ch.send(i) }
+out = t.await()`, 6)
+
+	// nested
+	expect(t, `out = go(func() { return go(func() { return 7 }).await() }).await()`, 7)
+
+	expectError(t, `go(func() { return 1 + "a" }).await()`)
+	expectError(t, `go(func(a) { return a }).await()`)
+	expectError(t, `go(1)`)
+	expectError(t, `go()`)
+}
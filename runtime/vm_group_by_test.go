@@ -0,0 +1,34 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	expect(t, `out = group_by([1, 2, 3, 4, 5], func(x) { return x % 2 == 0 ? "even" : "odd" })`,
+		MAP{"even": ARR{2, 4}, "odd": ARR{1, 3, 5}})
+	expect(t, `out = group_by([1, 2, 3, 4, 5], func(x) { return x % 3 })`,
+		MAP{"0": ARR{3}, "1": ARR{1, 4}, "2": ARR{2, 5}})
+	expect(t, `
+users := [{name: "a", team: "x"}, {name: "b", team: "y"}, {name: "c", team: "x"}]
+out = group_by(users, func(u) { return u.team })`,
+		MAP{
+			"x": ARR{MAP{"name": "a", "team": "x"}, MAP{"name": "c", "team": "x"}},
+			"y": ARR{MAP{"name": "b", "team": "y"}},
+		})
+	expect(t, `out = group_by(immutable([1, 2]), func(x) { return true })`, MAP{"true": ARR{1, 2}})
+	expect(t, `out = group_by([], func(x) { return x })`, MAP{})
+	expect(t, `out = group_by(["a", "bb", "cc"], len)`, MAP{"1": ARR{"a"}, "2": ARR{"bb", "cc"}})
+
+	// closures and nested calls
+	expect(t, `k := "team"; out = group_by([{team: 1}], func(x) { return x[k] })`, MAP{"1": ARR{MAP{"team": 1}}})
+	expect(t, `
+f := func(arr) { return group_by(arr, func(x) { return len(group_by(x, func(y) { return y })) }) }
+out = f([[1, 1], [1, 2]])`, MAP{"1": ARR{ARR{1, 1}}, "2": ARR{ARR{1, 2}}})
+
+	expectError(t, `group_by([1, 2], func(x) { return x + "a" })`) // key function error
+	expectError(t, `group_by([1, 2], func(x) { })`)                // undefined key
+	expectError(t, `group_by({a: 1}, func(x) { return x })`)
+	expectError(t, `group_by([1, 2], 1)`)
+	expectError(t, `group_by([1, 2])`)
+}
@@ -0,0 +1,41 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	// deterministic
+	expect(t, `out = hash(1) == hash(1)`, true)
+	expect(t, `out = hash("abc") == hash("abc")`, true)
+	expect(t, `out = hash([1, "a", [2.5]]) == hash([1, "a", [2.5]])`, true)
+	expect(t, `out = is_int(hash(undefined))`, true)
+
+	// same across the runs
+	expect(t, `out = hash(1)`, 8184434590310759885)
+	expect(t, `out = hash("abc")`, 2610014667279264335)
+	expect(t, `out = hash({a: [1, 2]})`, 4161417637972673700)
+
+	// the order of the map keys does not matter
+	expect(t, `a := {}; a.x = 1; a.y = 2; b := {}; b.y = 2; b.x = 1; out = hash(a) == hash(b)`, true)
+	expect(t, `out = hash({a: {b: 1, c: 2}}) == hash(immutable({a: {c: 2, b: 1}}))`, true)
+	expect(t, `out = hash(set([1, 2, 3])) == hash(set([3, 2, 1]))`, true)
+	expect(t, `out = hash([1, 2]) == hash(immutable([1, 2]))`, true)
+
+	// different values
+	expect(t, `out = hash(1) == hash(2)`, false)
+	expect(t, `out = hash(1) == hash(1.0)`, false)
+	expect(t, `out = hash(1) == hash("1")`, false)
+	expect(t, `out = hash("a") == hash(bytes("a"))`, false)
+	expect(t, `out = hash(["ab", "c"]) == hash(["a", "bc"])`, false)
+	expect(t, `out = hash([1, 2]) == hash([2, 1])`, false)
+	expect(t, `out = hash({a: 1}) == hash({a: 2})`, false)
+	expect(t, `out = hash({a: 1}) == hash({b: 1})`, false)
+	expect(t, `out = hash([]) == hash({})`, false)
+
+	// unhashable values
+	expectError(t, `hash(func() {})`)
+	expectError(t, `hash([1, len])`)
+	expectError(t, `hash({a: error("x")})`)
+	expectError(t, `hash()`)
+}
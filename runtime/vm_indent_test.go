@@ -0,0 +1,40 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestIndent(t *testing.T) {
+	expect(t, `out = indent("a\nb\nc", "  ")`, "  a\n  b\n  c")
+	expect(t, `out = indent("a\n\nb\n", "> ")`, "> a\n\n> b\n")
+	expect(t, `out = indent(" a", "\t")`, "\t a")
+	expect(t, `out = indent("", "  ")`, "")
+	expect(t, `out = indent("a", "")`, "a")
+
+	expectError(t, `indent("a")`)
+	expectError(t, `indent(1, " ")`)
+	expectError(t, `indent("a", 1)`)
+}
+
+func TestDedent(t *testing.T) {
+	expect(t, `out = dedent("    a\n      b\n    c")`, "a\n  b\nc")
+	expect(t, `out = dedent("  a\n    b\n")`, "a\n  b\n")
+	expect(t, `out = dedent("\ta\n\t\tb")`, "a\n\tb")
+	expect(t, `out = dedent("a\n  b")`, "a\n  b")
+	expect(t, `out = dedent("")`, "")
+
+	// blank lines
+	expect(t, `out = dedent("    a\n\n    b")`, "a\n\nb")
+	expect(t, `out = dedent("    a\n  \n    b")`, "a\n\nb")
+
+	// minimal common prefix
+	expect(t, `out = dedent("      a\n    b\n        c")`, "  a\nb\n    c")
+	expect(t, `out = dedent("  \ta\n  b")`, "\ta\nb")
+	expect(t, `out = dedent("\ta\n  b")`, "\ta\n  b")
+
+	// round trip
+	expect(t, `s := "a\n  b"; out = dedent(indent(s, "    "))`, "a\n  b")
+
+	expectError(t, `dedent()`)
+	expectError(t, `dedent(1)`)
+}
@@ -0,0 +1,46 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestIntBytes(t *testing.T) {
+	expect(t, `out = int_to_bytes(1, 1)`, []byte{1})
+	expect(t, `out = int_to_bytes(-1, 1)`, []byte{255})
+	expect(t, `out = int_to_bytes(-128, 1)`, []byte{128})
+	expect(t, `out = int_to_bytes(258, 2)`, []byte{1, 2})
+	expect(t, `out = int_to_bytes(258, 2, true)`, []byte{2, 1})
+	expect(t, `out = int_to_bytes(65535, 2, false)`, []byte{255, 255})
+	expect(t, `out = int_to_bytes(16909060, 4)`, []byte{1, 2, 3, 4})
+	expect(t, `out = int_to_bytes(16909060, 4, true)`, []byte{4, 3, 2, 1})
+	expect(t, `out = int_to_bytes(4294967295, 4)`, []byte{255, 255, 255, 255})
+	expect(t, `out = int_to_bytes(72623859790382856, 8)`, []byte{1, 2, 3, 4, 5, 6, 7, 8})
+	expect(t, `out = int_to_bytes(72623859790382856, 8, true)`, []byte{8, 7, 6, 5, 4, 3, 2, 1})
+	expect(t, `out = int_to_bytes(-1, 8)`, []byte{255, 255, 255, 255, 255, 255, 255, 255})
+
+	expect(t, `out = bytes_to_int(bytes("\xff"))`, 255)
+	expect(t, `out = bytes_to_int(bytes("\x01\x02"))`, 258)
+	expect(t, `out = bytes_to_int(bytes("\x01\x02"), true)`, 513)
+	expect(t, `out = bytes_to_int(bytes("\x01\x02\x03\x04"))`, 16909060)
+	expect(t, `out = bytes_to_int(bytes("\x04\x03\x02\x01"), true)`, 16909060)
+	expect(t, `out = bytes_to_int(bytes("\x01\x02\x03\x04\x05\x06\x07\x08"))`, 72623859790382856)
+	expect(t, `out = bytes_to_int(int_to_bytes(-1, 8))`, -1)
+	expect(t, `out = bytes_to_int(int_to_bytes(123456, 4, true), true)`, 123456)
+
+	// overflow
+	expectError(t, `int_to_bytes(256, 1)`)
+	expectError(t, `int_to_bytes(-129, 1)`)
+	expectError(t, `int_to_bytes(65536, 2)`)
+	expectError(t, `int_to_bytes(4294967296, 4)`)
+
+	// unsupported size
+	expectError(t, `int_to_bytes(1, 3)`)
+	expectError(t, `int_to_bytes(1, 0)`)
+	expectError(t, `bytes_to_int(bytes("\x01\x02\x03"))`)
+	expectError(t, `bytes_to_int(bytes(0))`)
+
+	expectError(t, `int_to_bytes(1)`)
+	expectError(t, `int_to_bytes("a", 1)`)
+	expectError(t, `int_to_bytes(1, 1, 1)`)
+	expectError(t, `bytes_to_int("a")`)
+}
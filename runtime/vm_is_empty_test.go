@@ -0,0 +1,27 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestIsEmpty(t *testing.T) {
+	expect(t, `out = is_empty("")`, true)
+	expect(t, `out = is_empty("a")`, false)
+	expect(t, `out = is_empty([])`, true)
+	expect(t, `out = is_empty([1])`, false)
+	expect(t, `out = is_empty(immutable([]))`, true)
+	expect(t, `out = is_empty(immutable([1]))`, false)
+	expect(t, `out = is_empty({})`, true)
+	expect(t, `out = is_empty({a: 1})`, false)
+	expect(t, `out = is_empty(immutable({}))`, true)
+	expect(t, `out = is_empty(immutable({a: 1}))`, false)
+	expect(t, `out = is_empty(bytes(""))`, true)
+	expect(t, `out = is_empty(bytes("a"))`, false)
+	expect(t, `out = is_empty(ordered_map())`, true)
+	expect(t, `out = is_empty(set([1]))`, false)
+
+	expectError(t, `is_empty(1)`)
+	expectError(t, `is_empty(undefined)`)
+	expectError(t, `is_empty()`)
+	expectError(t, `is_empty("", "")`)
+}
@@ -0,0 +1,27 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestIsSubset(t *testing.T) {
+	expect(t, `out = is_subset({a: 1}, {a: 1, b: 2})`, true)
+	expect(t, `out = is_subset({a: 1, b: 2}, {b: 2, a: 1})`, true)
+	expect(t, `out = is_subset({}, {a: 1})`, true)
+	expect(t, `out = is_subset({}, {})`, true)
+	expect(t, `out = is_subset({a: 1, b: 2}, {a: 1})`, false)
+	expect(t, `out = is_subset({a: 1}, {a: 2})`, false)
+	expect(t, `out = is_subset({a: 1}, {a: "1"})`, false)
+	expect(t, `out = is_subset({a: 1}, {b: 1})`, false)
+
+	// deep equality
+	expect(t, `out = is_subset({db: {host: "h", ports: [1, 2]}}, {db: {ports: [1, 2], host: "h"}, x: 1})`, true)
+	expect(t, `out = is_subset({db: {ports: [1, 2]}}, {db: {ports: [1, 3]}})`, false)
+	expect(t, `out = is_subset({db: {host: "h"}}, {db: {host: "h", port: 1}})`, false)
+
+	expect(t, `out = is_subset(immutable({a: 1}), readonly({a: 1, b: 2}))`, true)
+
+	expectError(t, `is_subset([1], {a: 1})`)
+	expectError(t, `is_subset({a: 1}, 1)`)
+	expectError(t, `is_subset({a: 1})`)
+}
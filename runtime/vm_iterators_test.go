@@ -0,0 +1,56 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestLazyIterators(t *testing.T) {
+	// range
+	expect(t, `out = collect(range(5))`, ARR{0, 1, 2, 3, 4})
+	expect(t, `out = collect(range(2, 5))`, ARR{2, 3, 4})
+	expect(t, `out = collect(range(10, 0, -3))`, ARR{10, 7, 4, 1})
+	expect(t, `out = collect(range(3, 3))`, ARR{})
+	expect(t, `out = collect(range(0, 3, -1))`, ARR{})
+	expect(t, `out = collect(range(9223372036854775800, 9223372036854775807, 10))`, ARR{int64(9223372036854775800)})
+	expect(t, `out = collect(range(-9223372036854775800, -9223372036854775807-1, -10))`, ARR{int64(-9223372036854775800)})
+	expect(t, `out = collect(range(9223372036854775805, 9223372036854775807))`, ARR{int64(9223372036854775805), int64(9223372036854775806)})
+	expect(t, `out = range(0, 3, 0)`, errorObject("invalid step: 0"))
+	expect(t, `out = type_name(range(3))`, "iterator")
+
+	// the elements are produced on demand
+	expect(t, `out = 0; for i in range(1000000000000) { if i == 3 { break }; out += i }`, 3)
+	expect(t, `out = []; for k, v in range(5, 8) { out = append(out, [k, v]) }`, ARR{ARR{0, 5}, ARR{1, 6}, ARR{2, 7}})
+
+	// the iterator can be consumed only once
+	expect(t, `r := range(3); collect(r); out = collect(r)`, ARR{})
+
+	// next protocol
+	expect(t, `r := range(10, 12); out = []; for r.next() { out = append(out, [r.key(), r.value()]) }`, ARR{ARR{0, 10}, ARR{1, 11}})
+	expect(t, `r := range(1); r.next(); r.next(); out = r.value()`, objects.UndefinedValue)
+
+	// lazy map and filter
+	expect(t, `out = collect(lazy_map(range(4), func(x) { return x * x }))`, ARR{0, 1, 4, 9})
+	expect(t, `out = collect(lazy_filter([1, 2, 3, 4, 5], func(x) { return x % 2 == 1 }))`, ARR{1, 3, 5})
+	expect(t, `
+evens := lazy_filter(range(1000000000000), func(x) { return x % 2 == 0 })
+squares := lazy_map(evens, func(x) { return x * x })
+out = []
+for v in squares {
+	if len(out) == 3 { break }
+	out = append(out, v)
+}`, ARR{0, 4, 16})
+	expect(t, `n := 0; it := lazy_map(range(10), func(x) { n++; return x }); it.next(); it.next(); out = n`, 2)
+	expect(t, `out = []; for k, v in lazy_map({a: 1}, func(x) { return x + 1 }) { out = [k, v] }`, ARR{"a", 2})
+	expect(t, `out = collect("abc")`, ARR{'a', 'b', 'c'})
+
+	// errors of the callbacks
+	expectError(t, `collect(lazy_map(range(3), func(x) { return x + "a" + [] }))`)
+	expectError(t, `for x in lazy_filter(range(3), func(x) { return x + [] }) {}`)
+	expectError(t, `it := lazy_map(range(3), func(x) { return x + [] }); it.next()`)
+	expectError(t, `range("a")`)
+	expectError(t, `range(1, 2, 3, 4)`)
+	expectError(t, `lazy_map(1, func(x) { return x })`)
+	expectError(t, `collect(1)`)
+}
@@ -0,0 +1,35 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	expect(t, `out = lines("a\nb\nc")`, ARR{"a", "b", "c"})
+	expect(t, `out = lines("a\n\nb")`, ARR{"a", "", "b"})
+	expect(t, `out = lines("")`, ARR{})
+	expect(t, `out = lines("\n")`, ARR{""})
+	expect(t, `out = lines("a\r")`, ARR{"a"})
+
+	// CRLF
+	expect(t, `out = lines("a\r\nb\r\n")`, ARR{"a", "b"})
+	expect(t, `out = lines("a\r\nb\nc")`, ARR{"a", "b", "c"})
+
+	// trailing newline
+	expect(t, `out = lines("a\nb\n")`, ARR{"a", "b"})
+	expect(t, `out = lines("a\nb\n\n")`, ARR{"a", "b", ""})
+
+	expect(t, `out = unlines(["a", "b"])`, "a\nb\n")
+	expect(t, `out = unlines(immutable([""]))`, "\n")
+	expect(t, `out = unlines([])`, "")
+
+	// round trip
+	expect(t, `a := ["a", "", "b"]; out = lines(unlines(a)) == a`, true)
+	expect(t, `a := [""]; out = lines(unlines(a)) == a`, true)
+	expect(t, `s := "a\nb\n"; out = unlines(lines(s)) == s`, true)
+
+	expectError(t, `lines()`)
+	expectError(t, `lines(1)`)
+	expectError(t, `unlines("a")`)
+	expectError(t, `unlines(["a", 1])`)
+}
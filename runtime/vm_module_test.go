@@ -9,6 +9,9 @@ func TestModule(t *testing.T) {
 	expect(t, `math := import("math"); out = math.abs(1.0)`, 1.0)
 	expect(t, `math := import("math"); out = math.abs(-1.0)`, 1.0)
 
+	// template
+	expect(t, `template := import("template"); out = template.parse("{{.a}}-{{.b}}").render({a: 1, b: "x"})`, "1-x")
+
 	// os.File
 	expect(t, `
 os := import("os")
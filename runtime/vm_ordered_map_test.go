@@ -0,0 +1,41 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestOrderedMap(t *testing.T) {
+	// iteration order matches insertion order
+	expect(t, `m := ordered_map(); m.c = 1; m.a = 2; m.b = 3; out = []; for k, v in m { out = append(out, k, v) }`,
+		ARR{"c", 1, "a", 2, "b", 3})
+	expect(t, `m := ordered_map(); m["z"] = 1; m["y"] = 2; out = m.z + m["y"]`, 3)
+	expect(t, `m := ordered_map(); out = m.x`, objects.UndefinedValue)
+	expect(t, `m := ordered_map({b: 1, a: 2}); out = []; for k, _ in m { out = append(out, k) }`, ARR{"a", "b"})
+	expect(t, `m := ordered_map(); m.a = 1; m.b = 2; out = len(m)`, 2)
+	expect(t, `m := ordered_map(); m.a = [1]; m.a[0] = 5; out = m.a`, ARR{5})
+
+	// updates keep the position
+	expect(t, `m := ordered_map(); m.a = 1; m.b = 2; m.a = 3; out = []; for k, v in m { out = append(out, k, v) }`,
+		ARR{"a", 3, "b", 2})
+
+	// deleting and re-inserting moves the key to the end
+	expect(t, `m := ordered_map(); m.a = 1; m.b = 2; delete(m, "a"); m.a = 3; out = []; for k, v in m { out = append(out, k, v) }`,
+		ARR{"b", 2, "a", 3})
+	expect(t, `m := ordered_map(); m.a = 1; delete(m, "x"); out = len(m)`, 1)
+	expect(t, `m := {a: 1, b: 2}; delete(m, "a"); out = m`, MAP{"b": 2})
+
+	// JSON encoding preserves the order
+	expect(t, `m := ordered_map(); m.z = 1; m.a = [true, "x"]; m.m = ordered_map(); m.m.y = 2; m.m.b = 3; out = string(to_json(m))`,
+		`{"z":1,"a":[true,"x"],"m":{"y":2,"b":3}}`)
+
+	expect(t, `m := ordered_map(); m.a = 1; c := copy(m); c.b = 2; out = [len(m), len(c)]`, ARR{1, 2})
+	expect(t, `m := ordered_map(); m.a = 1; out = m == {a: 1}`, true)
+	expect(t, `m := ordered_map(); m.a = 1; m.b = 2; out = string(m)`, "{a: 1, b: 2}")
+	expect(t, `out = type_name(ordered_map())`, "ordered-map")
+
+	expectError(t, `ordered_map(1)`)
+	expectError(t, `m := ordered_map(); m[1]`)
+	expectError(t, `delete([1], 0)`)
+}
@@ -0,0 +1,32 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestPadNumber(t *testing.T) {
+	// shorter than width
+	expect(t, `out = pad_number(42, 5)`, "00042")
+	expect(t, `out = pad_number(42, 5, ' ')`, "   42")
+	expect(t, `out = pad_number(42, 5, "*")`, "***42")
+	expect(t, `out = pad_number(7, 3, '·')`, "··7")
+
+	// exactly or longer than width
+	expect(t, `out = pad_number(12345, 5)`, "12345")
+	expect(t, `out = pad_number(12345, 3)`, "12345")
+	expect(t, `out = pad_number(0, 0)`, "0")
+	expect(t, `out = pad_number(1, -5)`, "1")
+
+	// negative numbers
+	expect(t, `out = pad_number(-42, 5)`, "-0042")
+	expect(t, `out = pad_number(-42, 5, ' ')`, "-  42")
+	expect(t, `out = pad_number(-42, 3)`, "-42")
+	expect(t, `out = pad_number(-9223372036854775807 - 1, 21)`, "-09223372036854775808")
+
+	expectError(t, `pad_number(42)`)
+	expectError(t, `pad_number(4.2, 5)`)
+	expectError(t, `pad_number(42, "5")`)
+	expectError(t, `pad_number(42, 5, "")`)
+	expectError(t, `pad_number(42, 5, "ab")`)
+	expectError(t, `pad_number(42, 5, 0)`)
+}
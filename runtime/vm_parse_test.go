@@ -0,0 +1,42 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	src := "`" + `
+math := import("math")
+const limit := 10
+a, b := [1, 2]
+{x, y: z} := {x: 1, y: 2}
+f := func() { return import("text").trim(" a ") }
+a = 3
+if a > 1 { c := 1 }
+print(f())
+` + "`"
+
+	expect(t, `out = parse(`+src+`).defines`, ARR{"math", "limit", "a", "b", "x", "z", "f"})
+	expect(t, `out = parse(`+src+`).imports`, ARR{"math", "text"})
+	expect(t, `s := parse(`+src+`).statements; out = [s[0], s[5], s[6].kind, s[7].line]`, ARR{
+		MAP{"kind": "assign", "line": 2, "defines": ARR{"math"}},
+		MAP{"kind": "assign", "line": 7, "defines": ARR{}},
+		"if",
+		9,
+	})
+	expect(t, `out = len(parse(`+src+`).statements)`, 8)
+	expect(t, `out = parse("for x in [1] { break }; a++").statements`, ARR{
+		MAP{"kind": "for_in", "line": 1, "defines": ARR{}},
+		MAP{"kind": "incdec", "line": 1, "defines": ARR{}},
+	})
+	expect(t, `out = parse("")`, MAP{"statements": ARR{}, "defines": ARR{}, "imports": ARR{}})
+
+	// the source is not run
+	expect(t, `a := 1; parse("a = 2"); out = a`, 1)
+
+	// syntax errors
+	expect(t, `out = parse("a := (1")`, errorObject("1:8: expected ')', found newline"))
+
+	expectError(t, `parse(1)`)
+	expectError(t, `parse()`)
+}
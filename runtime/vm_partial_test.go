@@ -0,0 +1,23 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestPartial(t *testing.T) {
+	expect(t, `sub := func(a, b) { return a - b }; f := partial(sub, 10); out = [f(1), f(3)]`, ARR{9, 7})
+	expect(t, `sub := func(a, b) { return a - b }; out = partial(sub, 10, 4)()`, 6)
+	expect(t, `sub := func(a, b) { return a - b }; out = partial(partial(sub), 10)(1)`, 9)
+	expect(t, `out = partial(append, [1], 2)(3)`, ARR{1, 2, 3})
+	expect(t, `out = partial(len)([1, 2])`, 2)
+	expect(t, `a := [1]; f := partial(func(x) { return len(x) }, a); a = append(a, 2); out = f()`, 1)
+
+	// arity is checked at call time
+	expect(t, `f := partial(func(a, b) { return a + b }, 1, 2, 3); out = 1`, 1)
+	expectError(t, `partial(func(a, b) { return a + b }, 1, 2, 3)()`)
+	expectError(t, `partial(func(a, b) { return a + b }, 1)()`)
+	expectError(t, `partial(func(a, b) { return a + b }, 1)(2, 3)`)
+
+	expectError(t, `partial(1, 2)`)
+	expectError(t, `partial()`)
+}
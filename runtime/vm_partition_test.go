@@ -0,0 +1,20 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestPartition(t *testing.T) {
+	expect(t, `out = partition([1, 2, 3, 4, 5], func(x) { return x % 2 == 0 })`, ARR{ARR{2, 4}, ARR{1, 3, 5}})
+	expect(t, `out = partition([1, "a", 2.5, "b", [], 3], is_string)`, ARR{ARR{"a", "b"}, ARR{1, 2.5, ARR{}, 3}})
+	expect(t, `out = partition([0, 1, "", "x", undefined], func(x) { return x })`, ARR{ARR{1, "x"}, ARR{0, "", objects.UndefinedValue}})
+	expect(t, `out = partition(immutable([3, 1, 2]), func(x) { return x > 1 })`, ARR{ARR{3, 2}, ARR{1}})
+	expect(t, `out = partition([], func(x) { return true })`, ARR{ARR{}, ARR{}})
+	expect(t, `a, b := partition([1, 2, 3], func(x) { return x < 3 }); out = [b, a]`, ARR{ARR{3}, ARR{1, 2}})
+
+	expectError(t, `partition([1, 2], func(x) { return x + "a" })`)
+	expectError(t, `partition("abc", func(x) { return true })`)
+	expectError(t, `partition([1, 2])`)
+}
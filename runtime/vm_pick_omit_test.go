@@ -0,0 +1,28 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestPickOmit(t *testing.T) {
+	expect(t, `out = pick({a: 1, b: 2, c: 3}, ["a", "c"])`, MAP{"a": 1, "c": 3})
+	expect(t, `out = pick({a: 1, b: 2}, ["a", "x", "y"])`, MAP{"a": 1})
+	expect(t, `out = pick({a: 1}, ["x"])`, MAP{})
+	expect(t, `out = pick({a: 1}, [])`, MAP{})
+	expect(t, `out = pick(immutable({a: 1, b: 2}), immutable(["b"]))`, MAP{"b": 2})
+	expect(t, `m := pick(immutable({a: 1, b: 2}), ["a"]); m.c = 3; out = m`, MAP{"a": 1, "c": 3})
+
+	expect(t, `out = omit({a: 1, b: 2, c: 3}, ["a", "c"])`, MAP{"b": 2})
+	expect(t, `out = omit({a: 1, b: 2}, ["x"])`, MAP{"a": 1, "b": 2})
+	expect(t, `out = omit({a: 1}, [])`, MAP{"a": 1})
+	expect(t, `m := omit(immutable({a: 1, b: 2}), ["a"]); m.c = 3; out = m`, MAP{"b": 2, "c": 3})
+
+	// returns a new map
+	expect(t, `m := {a: 1, b: 2}; p := pick(m, ["a"]); p.a = 9; out = m`, MAP{"a": 1, "b": 2})
+	expect(t, `m := {a: 1, b: 2}; o := omit(m, ["a"]); o.b = 9; out = m`, MAP{"a": 1, "b": 2})
+
+	expectError(t, `pick([1], ["a"])`)
+	expectError(t, `pick({a: 1}, "a")`)
+	expectError(t, `omit({a: 1}, [undefined])`)
+	expectError(t, `omit({a: 1})`)
+}
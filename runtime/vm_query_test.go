@@ -0,0 +1,28 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	// escaping and sorted keys
+	expect(t, `out = encode_query({q: "a b&c=d/é"})`, "q=a+b%26c%3Dd%2F%C3%A9")
+	expect(t, `out = encode_query({b: 2, a: 1, c: ["x", "y"]})`, "a=1&b=2&c=x&c=y")
+	expect(t, `out = encode_query(immutable({k: "v"}))`, "k=v")
+	expect(t, `out = encode_query({})`, "")
+
+	expectError(t, `encode_query("a=1")`)
+	expectError(t, `encode_query({a: undefined})`)
+	expectError(t, `encode_query()`)
+
+	// the last value wins for the repeated keys
+	expect(t, `out = decode_query("q=a+b%26c%3Dd%2F%C3%A9")`, MAP{"q": "a b&c=d/é"})
+	expect(t, `out = decode_query("a=1&b=&c")`, MAP{"a": "1", "b": "", "c": ""})
+	expect(t, `out = decode_query("a=1&a=2&a=3")`, MAP{"a": "3"})
+	expect(t, `out = decode_query("")`, MAP{})
+	expect(t, `out = is_error(decode_query("a=%zz"))`, true)
+	expect(t, `out = decode_query(encode_query({a: "x&y", b: "1"}))`, MAP{"a": "x&y", "b": "1"})
+
+	expectError(t, `decode_query(1)`)
+	expectError(t, `decode_query()`)
+}
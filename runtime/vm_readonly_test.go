@@ -0,0 +1,33 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestReadOnly(t *testing.T) {
+	// reads
+	expect(t, `m := readonly({a: 1, b: "x"}); out = [m.a, m["b"], m.c]`, ARR{1, "x", objects.UndefinedValue})
+	expect(t, `out = len(readonly({a: 1, b: 2}))`, 2)
+	expect(t, `out = type_name(readonly({}))`, "readonly-map")
+	expect(t, `out = !readonly({})`, true)
+	expect(t, `out = readonly({a: 1}) == {a: 1}`, true)
+	expect(t, `out = {a: 1} == readonly({a: 1})`, true)
+	expect(t, `m := {a: 1}; out = [readonly(m) == m, m == readonly(m)]`, ARR{true, true})
+	expect(t, `m := immutable({a: 1}); out = [readonly({a: 1}) == m, m == readonly({a: 1})]`, ARR{true, true})
+	expect(t, `out = {a: 1} == readonly({a: 2})`, false)
+	expect(t, `m := {a: 1, b: 2}; out = 0; for k, v in readonly(m) { out += v }`, 3)
+	expect(t, `m := readonly({a: 1}); c := copy(m); c.b = 2; out = [type_name(c), len(m)]`, ARR{"map", 1})
+	expect(t, `m := readonly({a: 1}); out = readonly(m) == m`, true)
+
+	// writes
+	expectError(t, `m := readonly({a: 1}); m.a = 2`)
+	expectError(t, `m := readonly({a: 1}); m["b"] = 2`)
+	expectError(t, `m := readonly({a: 1}); m.a += 1`)
+	expectError(t, `readonly([1])`)
+	expectError(t, `readonly()`)
+
+	// the view reflects the changes of the source
+	expect(t, `m := {a: 1}; v := readonly(m); m.a = 2; m.b = 3; out = [v.a, v.b, len(v)]`, ARR{2, 3, 2})
+}
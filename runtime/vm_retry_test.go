@@ -0,0 +1,28 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestRetry(t *testing.T) {
+	// success on the first try
+	expect(t, `n := 0; r := retry(func() { n++; return "ok" }, 3, 0); out = [r, n]`, ARR{"ok", 1})
+	expect(t, `out = retry(func() {}, 1, 0)`, objects.UndefinedValue)
+
+	// success after failures
+	expect(t, `n := 0; r := retry(func() { n++; return n < 3 ? error(n) : n }, 5, 0); out = [r, n]`, ARR{3, 3})
+	expect(t, `n := 0; a := unix_nano(); r := retry(func() { n++; return n < 3 ? error(n) : n }, 3, 1000000); out = [r, unix_nano() - a >= 2000000]`, ARR{3, true})
+
+	// exhaustion
+	expect(t, `n := 0; r := retry(func() { n++; return error(n) }, 3, 0); out = [r, n]`, ARR{errorObject(3), 3})
+	expect(t, `out = retry(func() { return error("down") }, 1, 1000000000)`, errorObject("down"))
+	expect(t, `out = is_error(retry(func() { return 1 }, 0, 0))`, true)
+
+	expectError(t, `retry(func() { return 1 + "a" }, 3, 0)`)
+	expectError(t, `retry(1, 3, 0)`)
+	expectError(t, `retry(func() {}, "3", 0)`)
+	expectError(t, `retry(func() {}, 3, 0.5)`)
+	expectError(t, `retry(func() {}, 3)`)
+}
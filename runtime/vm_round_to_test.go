@@ -0,0 +1,28 @@
+package runtime_test
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRoundTo(t *testing.T) {
+	expect(t, `out = round_to(3.14159, 2)`, 3.14)
+	expect(t, `out = round_to(2.675, 2)`, 2.68)
+	expect(t, `out = round_to(-2.675, 2)`, -2.68)
+	expect(t, `out = round_to(1.005, 2)`, 1.01)
+	expect(t, `out = round_to(2.5, 0)`, 3.0)
+	expect(t, `out = round_to(-2.5, 0)`, -3.0)
+	expect(t, `out = round_to(2.4999, 0)`, 2.0)
+	expect(t, `out = round_to(1234.5, -2)`, 1200.0)
+	expect(t, `out = round_to(1250, -2)`, 1300.0)
+	expect(t, `out = round_to(-1250, -2)`, -1300.0)
+	expect(t, `out = round_to(49, -2)`, 0.0)
+	expect(t, `out = round_to(1.5, 1000)`, 1.5)
+	expect(t, `out = round_to(1e300, -1000)`, 0.0)
+	expect(t, `out = is_nan(round_to(nan(), 2))`, true)
+	expect(t, `out = round_to(inf(), 2)`, math.Inf(1))
+
+	expectError(t, `round_to(1.5)`)
+	expectError(t, `round_to(1.5, 1.0)`)
+	expectError(t, `round_to("1.5", 1)`)
+}
@@ -0,0 +1,30 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestSame(t *testing.T) {
+	// reference types
+	expect(t, `a := [1, 2]; b := [1, 2]; out = [a == b, same(a, b)]`, ARR{true, false})
+	expect(t, `a := [1, 2]; b := a; out = same(a, b)`, true)
+	expect(t, `a := {x: 1}; b := a; b.y = 2; out = [same(a, b), a.y]`, ARR{true, 2})
+	expect(t, `a := {x: 1}; out = same(a, copy(a))`, false)
+	expect(t, `a := [1]; f := func(x) { return x }; out = same(a, f(a))`, true)
+	expect(t, `a := {b: [1]}; c := a.b; out = same(a.b, c)`, true)
+	expect(t, `f := func() {}; g := f; out = [same(f, g), same(f, func() {})]`, ARR{true, false})
+	expect(t, `out = same(len, len)`, true)
+
+	// scalars
+	expect(t, `out = same(1, 1)`, true)
+	expect(t, `out = same(1, 2)`, false)
+	expect(t, `out = same(1, 1.0)`, false)
+	expect(t, `out = same("a", "a")`, true)
+	expect(t, `out = same('a', "a")`, false)
+	expect(t, `out = same(true, true)`, true)
+	expect(t, `out = same(undefined, undefined)`, true)
+	expect(t, `out = same(bytes("a"), bytes("a"))`, true)
+	expect(t, `out = same(time(1), time(1))`, true)
+
+	expectError(t, `same(1)`)
+}
@@ -0,0 +1,117 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestSelect(t *testing.T) {
+	// receive
+	expect(t, `
+ch := channel(1); ch.send(5)
+select {
+case v := <-ch:
+	out = v
+}`, 5)
+	expect(t, `
+a := channel(1); b := channel(1); b.send("b")
+select {
+case v := <-a:
+	out = ["a", v]
+case v := <-b:
+	out = ["b", v]
+}`, ARR{"b", "b"})
+	expect(t, `
+ch := channel(1); ch.send(1); out = 0
+select {
+case <-ch:
+	out = ch.len()
+}`, 0)
+	expect(t, `
+ch := channel(1); ch.send(3); x := 0
+select {
+case x = <-ch:
+}
+out = x`, 3)
+	expect(t, `
+func() {
+	ch := channel(1); ch.send(4)
+	select {
+	case v := <-ch:
+		out = v * 2
+	}
+}()`, 8)
+
+	// send
+	expect(t, `
+ch := channel(1)
+select {
+case ch <- 7:
+	out = ch.receive()
+}`, 7)
+
+	// default
+	expect(t, `
+ch := channel()
+select {
+case v := <-ch:
+	out = v
+default:
+	out = "default"
+}`, "default")
+	expect(t, `
+ch := channel()
+select {
+case ch <- 1:
+	out = "sent"
+default:
+	out = "full"
+}`, "full")
+	expect(t, `select { default: out = 1 }`, 1)
+
+	// closed channel receives undefined
+	expect(t, `
+ch := channel(); ch.close()
+select {
+case v := <-ch:
+	out = v
+}`, objects.UndefinedValue)
+
+	// break and continue apply to the enclosing loop
+	expect(t, `
+ch := channel(3); ch.send(1); ch.send(2); ch.send(3); ch.close()
+out = 0
+for {
+	select {
+	case v := <-ch:
+		if is_undefined(v) { break }
+		out += v
+	}
+}`, 6)
+
+	// scope
+	expectError(t, `
+ch := channel(1); ch.send(1)
+select {
+case v := <-ch:
+}
+out = v`)
+
+	expectError(t, `select { case <-1: }`)
+	expectError(t, `ch := channel(); ch.close(); select { case ch <- 1: }`)
+	expectError(t, `select { default: default: }`)
+}
+
+func TestSelectKeywordKeys(t *testing.T) {
+	// 'select', 'case' and 'default' can be the map keys and the selectors
+	expect(t, `m := {default: 1, select: 2, case: 3}; out = m.default + m.select + m.case`, 6)
+	expect(t, `out = {case: 3}.case`, 3)
+	expect(t, `x := {}; x.select = 2; out = x["select"]`, 2)
+	expect(t, `
+m := {}
+m.default = 1
+out = m.default
+`, 1)
+	expect(t, `{default: d} := {default: 4}; out = d`, 4)
+}
@@ -0,0 +1,35 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestSetIn(t *testing.T) {
+	// creating a nested path
+	expect(t, `m := {}; set_in(m, ["a", "b", "c"], 1); out = m`, MAP{"a": MAP{"b": MAP{"c": 1}}})
+	expect(t, `out = set_in({}, ["a"], 1)`, MAP{"a": 1})
+	expect(t, `m := {a: undefined}; set_in(m, ["a", "b"], 1); out = m`, MAP{"a": MAP{"b": 1}})
+
+	// overwriting an existing leaf
+	expect(t, `m := {a: {b: 1, c: 2}}; set_in(m, ["a", "b"], 3); out = m`, MAP{"a": MAP{"b": 3, "c": 2}})
+	expect(t, `m := {a: {b: 1}}; r := set_in(m, ["a", "b"], 3); out = r == m`, true)
+
+	// arrays
+	expect(t, `out = set_in({x: [0, 0]}, ["x", 1], 5)`, MAP{"x": ARR{0, 5}})
+	expect(t, `out = set_in([{}, {}], [1, "a"], 5)`, ARR{MAP{}, MAP{"a": 5}})
+	expect(t, `out = set_in([undefined], [0, "a"], 5)`, ARR{MAP{"a": 5}})
+	expect(t, `out = set_in({a: [[1]]}, ["a", 0, 0], 2)`, MAP{"a": ARR{ARR{2}}})
+	expect(t, `out = set_in({}, immutable(["a"]), 1)`, MAP{"a": 1})
+
+	expectError(t, `set_in({}, ["a"])`)
+	expectError(t, `set_in({}, [], 1)`)
+	expectError(t, `set_in(1, ["a"], 1)`)
+	expectError(t, `set_in({}, "a", 1)`)
+	expectError(t, `set_in(immutable({}), ["a"], 1)`)
+	expectError(t, `set_in({a: immutable({})}, ["a", "b"], 1)`)
+	expectError(t, `set_in({a: immutable([1])}, ["a", 0], 1)`)
+	expectError(t, `set_in({a: 1}, ["a", "b"], 1)`)
+	expectError(t, `set_in([0], [1], 1)`)
+	expectError(t, `set_in([0], ["a"], 1)`)
+	expectError(t, `set_in({}, [1], 1)`)
+}
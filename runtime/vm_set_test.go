@@ -0,0 +1,43 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	// dedup on construction
+	expect(t, `out = len(set([1, 2, 2, 3, 1]))`, 3)
+	expect(t, `out = set([1, 1, "a", "a", 'a', 1.0]).len()`, 4)
+	expect(t, `out = len(set())`, 0)
+	expect(t, `out = len(set(immutable([1, 2, 1])))`, 2)
+	expect(t, `out = set([1, 2]) == set([2, 1, 2])`, true)
+	expect(t, `out = set([1, 2]) == set([1, 3])`, false)
+	expect(t, `out = is_set(set())`, true)
+	expect(t, `out = is_set([])`, false)
+	expect(t, `out = type_name(set())`, "set")
+	expect(t, `out = string(set([2, 1]))`, "set([1, 2])")
+	expect(t, `out = !set()`, true)
+
+	// membership
+	expect(t, `s := set([1, "a"]); out = [s.has(1), s.has("a"), s.has(2), s.has("1"), s.has([])]`, ARR{true, true, false, false, false})
+	expect(t, `s := set(); s.add(1, 2); s.add(2); out = [s.len(), s.has(2)]`, ARR{2, true})
+	expect(t, `s := set([1, 2, 3]); s.remove(2, 5); out = [s.len(), s.has(2)]`, ARR{2, false})
+	expect(t, `s := set([1]); c := copy(s); c.add(2); out = [len(s), len(c)]`, ARR{1, 2})
+	expect(t, `s := set([1, 2, 3]); out = 0; for v in s { out += v }`, 6)
+
+	// union/intersection/difference
+	expect(t, `out = set([1, 2]).union(set([2, 3])) == set([1, 2, 3])`, true)
+	expect(t, `out = set([1, 2]).union([3]) == set([1, 2, 3])`, true)
+	expect(t, `out = set([1, 2, 3]).intersection(set([2, 3, 4])) == set([2, 3])`, true)
+	expect(t, `out = set([1, 2]).intersection([5]).len()`, 0)
+	expect(t, `out = set([1, 2, 3]).difference(set([2])) == set([1, 3])`, true)
+	expect(t, `a := set([1]); b := a.union([2]); out = [len(a), len(b)]`, ARR{1, 2})
+
+	// unhashable elements
+	expectError(t, `set([[1]])`)
+	expectError(t, `set([{}])`)
+	expectError(t, `set().add(undefined)`)
+	expectError(t, `set(1)`)
+	expectError(t, `set().union(1)`)
+	expectError(t, `s := set(); s.x = 1`)
+}
@@ -0,0 +1,24 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestBuiltinShadowing(t *testing.T) {
+	// top-level variables can shadow the builtin functions
+	expect(t, `count := 0; for i := 0; i < 3; i++ { count += i }; out = count`, 3)
+	expect(t, `set := {}; set.a = 1; get := func(k) { return set[k] }; out = get("a")`, 1)
+	expect(t, `lines := ["a", "b"]; range := len(lines); out = range`, 2)
+	expect(t, `const hash := 5; out = hash`, 5)
+	expect(t, `a, sorted := [1, 2]; out = sorted`, 2)
+	expect(t, `{parse} := {parse: 1}; out = parse`, 1)
+	expect(t, `len := func(x) { return 42 }; out = len([1])`, 42)
+	expect(t, `len := 1; f := func() { return len }; out = f()`, 1)
+
+	// the other scopes still use the builtin functions
+	expect(t, `f := func() { return len([1, 2]) }; len := 1; out = f() + len`, 3)
+	expect(t, `if true { len := 5 }; out = len([1])`, 1)
+
+	expectError(t, `count := 1; count := 2`)
+	expectError(t, `len = 1`)
+}
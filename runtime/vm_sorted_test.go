@@ -0,0 +1,27 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestSorted(t *testing.T) {
+	expect(t, `out = sorted([3, 1, 2])`, ARR{1, 2, 3})
+	expect(t, `out = sorted([2.5, -1, 2])`, ARR{-1, 2, 2.5})
+	expect(t, `out = sorted(["b", "c", "a"])`, ARR{"a", "b", "c"})
+	expect(t, `out = sorted(['b', 'a'])`, ARR{'a', 'b'})
+	expect(t, `out = sorted(immutable([2, 1]))`, ARR{1, 2})
+	expect(t, `out = sorted([])`, ARR{})
+	expect(t, `out = sorted([1, 2, 3], func(a, b) { return a > b })`, ARR{3, 2, 1})
+	expect(t, `out = sorted(["bb", "a", "cc", "d"], func(a, b) { return len(a) < len(b) })`, ARR{"a", "d", "bb", "cc"})
+
+	// the source array is unchanged
+	expect(t, `a := [3, 1, 2]; b := sorted(a); out = [a, b]`, ARR{ARR{3, 1, 2}, ARR{1, 2, 3}})
+	expect(t, `a := [3, 1, 2]; sorted(a, func(x, y) { return x < y }); out = a`, ARR{3, 1, 2})
+
+	expectError(t, `sorted()`)
+	expectError(t, `sorted(1)`)
+	expectError(t, `sorted([1, "a"])`)
+	expectError(t, `sorted([{}, {}])`)
+	expectError(t, `sorted([1, 2], func(a) { return true })`)
+	expectError(t, `sorted([1, 2], 1)`)
+}
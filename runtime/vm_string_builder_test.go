@@ -0,0 +1,33 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestStringBuilder(t *testing.T) {
+	expect(t, `b := builder(); out = b.string()`, "")
+	expect(t, `b := builder("a", 1, 'c'); out = b.string()`, "a1c")
+	expect(t, `b := builder(); b.append("x").append("y", [1, 2]); out = b.string()`, "xy[1, 2]")
+	expect(t, `b := builder("한글"); out = [b.len(), len(b)]`, ARR{2, 2})
+	expect(t, `b := builder("ab"); b.reset(); out = [b.string(), is_empty(b)]`, ARR{"", true})
+	expect(t, `out = string(builder("a", "b"))`, "ab")
+	expect(t, `out = type_name(builder())`, "string-builder")
+	expect(t, `b := builder("a"); c := copy(b); c.append("b"); out = [b.string(), c.string()]`, ARR{"a", "ab"})
+	expect(t, `out = builder("a") == builder("a")`, true)
+	expect(t, `out = !builder()`, true)
+
+	// same result as the string concatenation
+	expect(t, `
+s := ""
+b := builder()
+for i := 0; i < 100; i++ {
+	s += i
+	s += "," + 'c'
+	b.append(i, ",", 'c')
+}
+out = s == b.string()`, true)
+
+	expectError(t, `builder().string(1)`)
+	expectError(t, `builder() + "a"`)
+	expectError(t, `builder()[1]`)
+}
@@ -0,0 +1,34 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestTakeDrop(t *testing.T) {
+	expect(t, `out = take([1, 2, 3, 4], 2)`, ARR{1, 2})
+	expect(t, `out = take([1, 2, 3, 4], 0)`, ARR{})
+	expect(t, `out = take([1, 2, 3, 4], 4)`, ARR{1, 2, 3, 4})
+	expect(t, `out = take([1, 2, 3, 4], 10)`, ARR{1, 2, 3, 4})
+	expect(t, `out = take([1, 2, 3, 4], -1)`, ARR{4})
+	expect(t, `out = take([1, 2, 3, 4], -3)`, ARR{2, 3, 4})
+	expect(t, `out = take([1, 2, 3, 4], -10)`, ARR{1, 2, 3, 4})
+	expect(t, `out = take(immutable([1, 2, 3]), 2)`, ARR{1, 2})
+	expect(t, `out = take([], 2)`, ARR{})
+
+	expect(t, `out = drop([1, 2, 3, 4], 1)`, ARR{2, 3, 4})
+	expect(t, `out = drop([1, 2, 3, 4], 0)`, ARR{1, 2, 3, 4})
+	expect(t, `out = drop([1, 2, 3, 4], 4)`, ARR{})
+	expect(t, `out = drop([1, 2, 3, 4], 10)`, ARR{})
+	expect(t, `out = drop([1, 2, 3, 4], -1)`, ARR{1, 2, 3})
+	expect(t, `out = drop([1, 2, 3, 4], -3)`, ARR{1})
+	expect(t, `out = drop([1, 2, 3, 4], -10)`, ARR{})
+	expect(t, `out = drop(immutable([1, 2, 3]), 2)`, ARR{3})
+
+	// returns a new array
+	expect(t, `a := [1, 2, 3]; b := take(a, 2); b = append(b, 9); out = a`, ARR{1, 2, 3})
+	expect(t, `a := [1, 2, 3]; b := drop(a, 1); b[0] = 9; out = a`, ARR{1, 2, 3})
+
+	expectError(t, `take([1, 2], "1")`)
+	expectError(t, `take("abc", 1)`)
+	expectError(t, `drop([1, 2])`)
+}
@@ -0,0 +1,59 @@
+package runtime
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/d5/tengo/objects"
+)
+
+// CallTimeout calls the function like Call but on a child VM that can be
+// aborted separately from v. If the function does not return within the
+// timeout, the child VM is aborted and CallTimeout returns
+// objects.ErrTimeout. The state of v is not affected by the abort. The call
+// is aborted along with v as well.
+func (v *VM) CallTimeout(fn objects.Object, timeout time.Duration, args ...objects.Object) (objects.Object, error) {
+	child := v.newChild(v.globals)
+	child.aborting = new(int64)
+
+	// 1 if timed out, 2 if returned
+	var state int64
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	go func() {
+		defer close(stopped)
+
+		select {
+		case <-timer.C:
+			if atomic.CompareAndSwapInt64(&state, 0, 1) {
+				child.Abort()
+			}
+		case <-v.Done():
+			child.Abort()
+		case <-stop:
+		}
+	}()
+
+	var ret objects.Object
+	var err error
+	switch fn.(type) {
+	case *objects.Closure, *objects.CompiledFunction:
+		ret, err = child.call(fn, args)
+	default:
+		ret, err = child.Call(fn, args...)
+	}
+
+	returned := atomic.CompareAndSwapInt64(&state, 0, 2)
+	close(stop)
+	<-stopped
+
+	if !returned {
+		return nil, objects.ErrTimeout
+	}
+
+	return ret, err
+}
@@ -0,0 +1,23 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestTimes(t *testing.T) {
+	expect(t, `out = times(5, func(i) { return i * i })`, ARR{0, 1, 4, 9, 16})
+	expect(t, `out = times(0, func(i) { return i })`, ARR{})
+	expect(t, `idx := []; times(3, func(i) { idx = append(idx, i) }); out = idx`, ARR{0, 1, 2})
+	expect(t, `out = times(2, func(i) {})`, ARR{objects.UndefinedValue, objects.UndefinedValue})
+	expect(t, `out = times(-1, func(i) { return i })`, &objects.Error{Value: &objects.String{Value: "invalid count: -1"}})
+
+	expect(t, `out = is_error(times(1, len)[0])`, true) // len(0) is an error
+
+	expectError(t, `times(3)`)
+	expectError(t, `times("3", func(i) { return i })`)
+	expectError(t, `times(3, 1)`)
+	expectError(t, `times(3, func() { return 1 })`)
+	expectError(t, `times(3, func(i) { return i / 0 })`)
+}
@@ -0,0 +1,26 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestCharStringConversions(t *testing.T) {
+	// char to string
+	expect(t, `out = string('a')`, "a")
+	expect(t, `out = string('한')`, "한")
+	expect(t, `out = len(string('한'))`, 1)
+
+	// string to char
+	expect(t, `out = to_char("a")`, 'a')
+	expect(t, `out = to_char("한글")`, '한')
+	expect(t, `out = to_char(string('x'))`, 'x')
+	expect(t, `out = to_char("")`, &objects.Error{Value: &objects.String{Value: "empty string"}})
+	expect(t, `out = char("a")`, objects.UndefinedValue)
+	expect(t, `out = char("a", 'z')`, 'z')
+
+	expectError(t, `to_char()`)
+	expectError(t, `to_char('a')`)
+	expectError(t, `to_char(97)`)
+}
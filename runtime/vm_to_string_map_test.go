@@ -0,0 +1,24 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestToStringMap(t *testing.T) {
+	expect(t, `out = to_string_map({})`, MAP{})
+	expect(t, `out = to_string_map({a: 1, b: -2})`, MAP{"a": "1", "b": "-2"})
+	expect(t, `out = to_string_map({a: true, b: false})`, MAP{"a": "true", "b": "false"})
+	expect(t, `out = to_string_map({a: 1.5, b: 2.0})`, MAP{"a": "1.5", "b": "2"})
+	expect(t, `out = to_string_map({a: "x", b: 'y', c: undefined, d: bytes("z")})`, MAP{"a": "x", "b": "y", "c": "", "d": "z"})
+	expect(t, `out = to_string_map(immutable({a: 1}))`, MAP{"a": "1"})
+
+	// one level deep
+	expect(t, `out = to_string_map({a: [1, true, [2]]})`, MAP{"a": ARR{"1", "true", "[2]"}})
+	expect(t, `out = to_string_map({a: {b: 1, c: {d: 2}}})`, MAP{"a": MAP{"b": "1", "c": "{d: 2}"}})
+
+	// the source map is not modified
+	expect(t, `m := {a: 1, b: {c: 2}}; to_string_map(m); out = m`, MAP{"a": 1, "b": MAP{"c": 2}})
+
+	expectError(t, `to_string_map([1])`)
+	expectError(t, `to_string_map()`)
+}
@@ -0,0 +1,36 @@
+package runtime
+
+import (
+	"sync/atomic"
+
+	"github.com/d5/tengo/compiler"
+)
+
+// opcodeCounts is the number of the executed instructions per opcode.
+type opcodeCounts [256]int64
+
+// EnableOpcodeCounts makes the VM count the executed instructions per
+// opcode and resets the counts. The functions called by the builtin
+// functions (e.g. in 'go') are counted too. Counting is disabled by default.
+func (v *VM) EnableOpcodeCounts() {
+	v.opcodeCounts = new(opcodeCounts)
+	v.children = nil // the cached child VMs have the previous counts
+}
+
+// OpcodeCounts returns the number of the executed instructions per opcode
+// name since EnableOpcodeCounts was called. It returns nil if counting is
+// not enabled.
+func (v *VM) OpcodeCounts() map[string]int64 {
+	if v.opcodeCounts == nil {
+		return nil
+	}
+
+	counts := make(map[string]int64)
+	for op, name := range compiler.OpcodeNames {
+		if n := atomic.LoadInt64(&v.opcodeCounts[op]); n > 0 {
+			counts[name] = n
+		}
+	}
+
+	return counts
+}
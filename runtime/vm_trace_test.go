@@ -0,0 +1,75 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/assert"
+	"github.com/d5/tengo/compiler"
+	"github.com/d5/tengo/compiler/parser"
+	"github.com/d5/tengo/compiler/source"
+	"github.com/d5/tengo/objects"
+	"github.com/d5/tengo/runtime"
+)
+
+func TestVM_OpcodeCounts(t *testing.T) {
+	// disabled by default
+	v := traceVM(t, `a := 1`)
+	assert.NoError(t, v.Run())
+	assert.True(t, v.OpcodeCounts() == nil)
+
+	v = traceVM(t, `a := 0; for i := 0; i < 3; i++ { a += i }`)
+	v.EnableOpcodeCounts()
+	assert.NoError(t, v.Run())
+	expectOpcodeCounts(t, map[string]int64{
+		"CONST": 9,  // 0, 0, 3 (x4), 1 (x3)
+		"SETG":  8,  // a, i, a (x3), i (x3)
+		"GETG":  13, // i (x4), a and i (x3), i (x3)
+		"GTR":   4,
+		"JMPF":  4,
+		"ADD":   6,
+		"JMP":   3,
+	}, v.OpcodeCounts())
+
+	// the counts accumulate over the runs until enabled again
+	assert.NoError(t, v.Run())
+	assert.Equal(t, int64(18), v.OpcodeCounts()["CONST"])
+	v.EnableOpcodeCounts()
+	assert.Equal(t, 0, len(v.OpcodeCounts()))
+
+	// the functions called by the builtin functions are counted
+	v = traceVM(t, `f := func(x) { return x }; lazy_map([1, 2], f)`)
+	v.EnableOpcodeCounts()
+	assert.NoError(t, v.Run())
+	assert.Equal(t, int64(0), v.OpcodeCounts()["RETVAL"])
+	v = traceVM(t, `f := func(x) { return x }; collect(lazy_map([1, 2], f))`)
+	v.EnableOpcodeCounts()
+	assert.NoError(t, v.Run())
+	assert.Equal(t, int64(2), v.OpcodeCounts()["RETVAL"])
+}
+
+func expectOpcodeCounts(t *testing.T, expected, actual map[string]int64) {
+	assert.Equal(t, len(expected), len(actual), actual)
+	for name, n := range expected {
+		assert.Equal(t, n, actual[name], name)
+	}
+}
+
+func traceVM(t *testing.T, input string) *runtime.VM {
+	fileSet := source.NewFileSet()
+	file, err := parser.ParseFile(fileSet.AddFile("test", -1, len(input)), []byte(input), nil)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	symbolTable := compiler.NewSymbolTable()
+	for idx, fn := range objects.Builtins {
+		symbolTable.DefineBuiltin(idx, fn.Name)
+	}
+
+	c := compiler.NewCompiler(symbolTable, nil, nil)
+	if !assert.NoError(t, c.Compile(file)) {
+		t.FailNow()
+	}
+
+	return runtime.NewVM(c.Bytecode(), nil)
+}
@@ -0,0 +1,27 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestUnique(t *testing.T) {
+	expect(t, `out = unique([3, 1, 3, 2, 1])`, ARR{3, 1, 2})
+	expect(t, `out = unique(["b", "a", "b", "c", "a"])`, ARR{"b", "a", "c"})
+	expect(t, `out = unique([1, 1.0, "1", '1', true, 1, true])`, ARR{1, 1.0, "1", '1', true})
+	expect(t, `out = unique([bytes("a"), "a", bytes("a")])`, ARR{[]byte("a"), "a"})
+	expect(t, `out = unique(immutable([2, 2, 1]))`, ARR{2, 1})
+	expect(t, `out = unique([])`, ARR{})
+
+	// containers
+	expect(t, `out = unique([{a: 1}, {a: 2}, {a: 1}, {a: 2, b: 3}])`, ARR{MAP{"a": 1}, MAP{"a": 2}, MAP{"a": 2, "b": 3}})
+	expect(t, `out = unique([[1, 2], 1, [1, 2], [2, 1], 1])`, ARR{ARR{1, 2}, 1, ARR{2, 1}})
+	expect(t, `out = unique([undefined, 1, undefined])`, ARR{objects.UndefinedValue, 1})
+
+	// returns a new array
+	expect(t, `a := [1, 2]; b := unique(a); b[0] = 9; out = a`, ARR{1, 2})
+
+	expectError(t, `unique("aab")`)
+	expectError(t, `unique([1], [2])`)
+}
@@ -0,0 +1,48 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestStripBOM(t *testing.T) {
+	expect(t, `out = strip_bom("\xef\xbb\xbfname,age")`, "name,age")
+	expect(t, `out = strip_bom("name,age")`, "name,age")
+	expect(t, `out = strip_bom("\xef\xbb\xbf")`, "")
+	expect(t, `out = strip_bom("\xef\xbb\xbf\xef\xbb\xbfa")`, "\xef\xbb\xbfa")
+	expect(t, `out = strip_bom("a\xef\xbb\xbf")`, "a\xef\xbb\xbf")
+	expect(t, `out = strip_bom("\xef\xbb")`, "\xef\xbb")
+	expect(t, `out = strip_bom(bytes("\xef\xbb\xbfabc"))`, []byte("abc"))
+	expect(t, `out = strip_bom(bytes("abc"))`, []byte("abc"))
+	expect(t, `out = strip_bom(bytes(""))`, []byte(""))
+
+	expectError(t, `strip_bom()`)
+	expectError(t, `strip_bom(1)`)
+	expectError(t, `strip_bom("a", "b")`)
+}
+
+func TestUTF8Validation(t *testing.T) {
+	// valid input
+	expect(t, `out = is_valid_utf8(bytes("héllo, 世界"))`, true)
+	expect(t, `out = utf8_error_at(bytes("héllo, 世界"))`, -1)
+	expect(t, `out = is_valid_utf8(bytes(""))`, true)
+	expect(t, `out = utf8_error_at(bytes(""))`, -1)
+	expect(t, `out = utf8_error_at(bytes("\xef\xbf\xbd"))`, -1) // U+FFFD itself is valid
+
+	// truncated multibyte sequence
+	expect(t, `out = is_valid_utf8(bytes("ab\xe4\xb8"))`, false)
+	expect(t, `out = utf8_error_at(bytes("ab\xe4\xb8"))`, 2)
+	expect(t, `out = utf8_error_at(bytes("世\xe4\xb8x"))`, 3)
+
+	// invalid lead byte
+	expect(t, `out = is_valid_utf8(bytes("\xffabc"))`, false)
+	expect(t, `out = utf8_error_at(bytes("\xffabc"))`, 0)
+	expect(t, `out = utf8_error_at(bytes("abc\x80"))`, 3)
+
+	// strings
+	expect(t, `out = is_valid_utf8("h\xe9llo")`, false)
+	expect(t, `out = utf8_error_at("h\xe9llo")`, 1)
+
+	expectError(t, `is_valid_utf8()`)
+	expectError(t, `is_valid_utf8(1)`)
+	expectError(t, `utf8_error_at([1])`)
+}
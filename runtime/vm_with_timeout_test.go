@@ -0,0 +1,45 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestWithTimeout(t *testing.T) {
+	// returns normally
+	expect(t, `out = with_timeout(func() { return 5 }, 1000000000)`, 5)
+	expect(t, `out = with_timeout(func() {}, 1000000000)`, objects.UndefinedValue)
+	expect(t, `a := 1; with_timeout(func() { a = 2 }, 1000000000); out = a`, 2)
+	expect(t, `f := func(x) { return x * 2 }; out = with_timeout(func() { return f(3) }, 1000000000)`, 6)
+	expect(t, `out = with_timeout(func() { return error("x") }, 1000000000)`, errorObject("x"))
+
+	// times out
+	expect(t, `out = with_timeout(func() { for true {} }, 1000000)`, errorObject("timeout after 1ms"))
+	expect(t, `out = with_timeout(func() { sleep(10000000000) }, 1000000)`, errorObject("timeout after 1ms"))
+	expect(t, `f := func() { for true {} }; out = with_timeout(func() { return f() }, 1000000)`, errorObject("timeout after 1ms"))
+	expect(t, `out = with_timeout(func() { return times(30000000, func(i) { return i }) }, 10000000)`, errorObject("timeout after 10ms"))
+	expect(t, `out = with_timeout(func() { return count_by([1, 2, 3], func(v) { for true {} }) }, 1000000)`, errorObject("timeout after 1ms"))
+
+	// the caller keeps running after the timeout
+	expect(t, `
+a := [1, 2]
+r := with_timeout(func() { for true { a[0]++ } }, 1000000)
+out = [is_error(r), len(a), a[1], [1, 2, 3][2], with_timeout(func() { return a[1] }, 1000000000)]
+`, ARR{true, 2, 2, 3, 2})
+	expect(t, `
+sum := 0
+for i := 0; i < 3; i++ {
+	r := with_timeout(func() { for true {} }, 1000000)
+	sum += is_error(r) ? 1 : 0
+}
+out = sum
+`, 3)
+
+	expect(t, `out = is_error(with_timeout(func() { return 1 }, 0))`, true)
+
+	expectError(t, `with_timeout(func() { return 1 + "a" }, 1000000000)`)
+	expectError(t, `with_timeout(1, 1000000000)`)
+	expectError(t, `with_timeout(func() {}, "1s")`)
+	expectError(t, `with_timeout(func() {})`)
+}
@@ -0,0 +1,30 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/d5/tengo/objects"
+)
+
+func TestWordWrap(t *testing.T) {
+	// long paragraph
+	expect(t, `out = word_wrap("the quick brown fox jumps over the lazy dog", 10)`, "the quick\nbrown fox\njumps over\nthe lazy\ndog")
+	expect(t, `out = word_wrap("the quick brown fox", 100)`, "the quick brown fox")
+	expect(t, `out = word_wrap("a  b   c", 3)`, "a b\nc")
+	expect(t, `out = word_wrap("héllo wörld", 5)`, "héllo\nwörld")
+	expect(t, `out = word_wrap("", 5)`, "")
+
+	// existing newlines
+	expect(t, `out = word_wrap("aaa bbb\nccc ddd", 7)`, "aaa bbb\nccc ddd")
+	expect(t, `out = word_wrap("aaa bbb ccc\n\nddd", 7)`, "aaa bbb\nccc\n\nddd")
+
+	// over-long words
+	expect(t, `out = word_wrap("extraordinary", 5)`, "extraordinary")
+	expect(t, `out = word_wrap("an extraordinary day", 5)`, "an\nextraordinary\nday")
+
+	expect(t, `out = word_wrap("a", 0)`, &objects.Error{Value: &objects.String{Value: "invalid width: 0"}})
+
+	expectError(t, `word_wrap("a")`)
+	expectError(t, `word_wrap(1, 5)`)
+	expectError(t, `word_wrap("a", "5")`)
+}
@@ -0,0 +1,25 @@
+package runtime_test
+
+import (
+	"testing"
+)
+
+func TestZipMap(t *testing.T) {
+	expect(t, `out = zip_map([], [])`, MAP{})
+	expect(t, `out = zip_map(["a", "b"], [1, 2])`, MAP{"a": 1, "b": 2})
+	expect(t, `out = zip_map(["a", "a"], [1, 2])`, MAP{"a": 2})
+	expect(t, `out = zip_map(immutable(["a"]), immutable([[1]]))`, MAP{"a": ARR{1}})
+
+	// ragged
+	expect(t, `out = zip_map(["a", "b", "c"], [1])`, MAP{"a": 1})
+	expect(t, `out = zip_map(["a"], [1, 2, 3])`, MAP{"a": 1})
+	expect(t, `out = zip_map([], [1])`, MAP{})
+
+	// key conversion
+	expect(t, `out = zip_map([1, 2.5, 'c', true], ["i", "f", "c", "b"])`, MAP{"1": "i", "2.5": "f", "c": "c", "true": "b"})
+
+	expectError(t, `zip_map([[1]], [1])`)
+	expectError(t, `zip_map([undefined], [1])`)
+	expectError(t, `zip_map(["a"], 1)`)
+	expectError(t, `zip_map(["a"])`)
+}
@@ -14,6 +14,7 @@ import (
 type Compiled struct {
 	symbolTable *compiler.SymbolTable
 	machine     *runtime.VM
+	inputs      []*objects.Object // values of the variables added to Script
 }
 
 // Run executes the compiled script in the virtual machine.
@@ -21,11 +22,39 @@ func (c *Compiled) Run() error {
 	return c.machine.Run()
 }
 
+// ResetAndRun resets the global variables and executes the compiled script
+// again, reusing the virtual machine of the previous runs. The variables
+// added to the script (with Script.Add or Compiled.Set) are restored to their
+// values before the execution, and all the other global variables are
+// cleared so that the previous runs do not affect the new run.
+func (c *Compiled) ResetAndRun() error {
+	globals := c.machine.Globals()
+	copy(globals, c.inputs)
+	for i := len(c.inputs); i < len(globals); i++ {
+		globals[i] = nil
+	}
+
+	return c.machine.Run()
+}
+
+// CoveredLines returns the sorted line numbers of the statements executed
+// in all the runs. It returns nil unless Script.EnableCoverage was enabled.
+func (c *Compiled) CoveredLines() []int {
+	return c.machine.CoveredLines()
+}
+
 // RunContext is like Run but includes a context.
 func (c *Compiled) RunContext(ctx context.Context) (err error) {
 	ch := make(chan error, 1)
 
 	go func() {
+		// the caller cannot recover a panic of this goroutine
+		defer func() {
+			if r := recover(); r != nil {
+				ch <- fmt.Errorf("panic: %v", r)
+			}
+		}()
+
 		ch <- c.machine.Run()
 	}()
 
@@ -108,6 +137,9 @@ func (c *Compiled) Set(name string, value interface{}) error {
 	}
 
 	c.machine.Globals()[symbol.Index] = &obj
+	if symbol.Index < len(c.inputs) {
+		c.inputs[symbol.Index] = &obj
+	}
 
 	return nil
 }
@@ -1,11 +1,14 @@
 package script_test
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/d5/tengo/assert"
+	"github.com/d5/tengo/objects"
 	"github.com/d5/tengo/script"
 )
 
@@ -100,8 +103,232 @@ func TestCompiled_RunContext(t *testing.T) {
 	defer cancel()
 	err = c.RunContext(ctx)
 	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// cancelled while calling a function from a builtin function
+	c = compile(t, `times(20000000, func(i) { return i })`, nil)
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = c.RunContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(start) < time.Second)
+
+	// cancelled while blocked on channel receive
+	c = compile(t, `ch := channel(); ch.receive()`, nil)
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	err = c.RunContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// cancelled while blocked on channel send
+	c = compile(t, `ch := channel(1); ch.send(1); ch.send(2)`, nil)
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	err = c.RunContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// cancelled while blocked on select
+	c = compile(t, `a := channel(); b := channel(); select { case <-a: case b <- 1: }`, nil)
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	err = c.RunContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// sleep is interrupted
+	c = compile(t, `sleep(10000000000)`, nil)
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	err = c.RunContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(start) < time.Second)
+
+	// retry is interrupted while pausing
+	c = compile(t, `retry(func() { return error("x") }, 100, 10000000000)`, nil)
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	err = c.RunContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(start) < time.Second)
+
+	// with_timeout is aborted along with the script
+	c = compile(t, `with_timeout(func() { for true {} }, 10000000000)`, nil)
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	err = c.RunContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(start) < time.Second)
+
+	// panic is returned as an error
+	c = compile(t, `p()`, M{"p": &objects.UserFunction{Value: func(args ...objects.Object) (objects.Object, error) {
+		panic("boom")
+	}}})
+	err = c.RunContext(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, "panic: boom", err.Error())
+
+	// values sent from Go
+	ch := objects.NewChannel(0)
+	go func() {
+		for i := int64(1); i <= 3; i++ {
+			_, _ = ch.Send(&objects.Int{Value: i}, nil)
+		}
+		_ = ch.Close()
+	}()
+	c = compile(t, `a := 0; for { v := ch.receive(); if is_undefined(v) { break }; a += v }`, M{"ch": ch})
+	err = c.RunContext(context.Background())
+	assert.NoError(t, err)
+	compiledGet(t, c, "a", int64(6))
+}
+
+func TestCompiled_ResetAndRun(t *testing.T) {
+	c := compile(t, `a := b; b += 1; assert(b > 0); d := 10 / b`, M{"b": 1})
+	compiledRun(t, c)
+	compiledGet(t, c, "a", int64(1))
+	compiledGet(t, c, "b", int64(2))
+	compiledGet(t, c, "d", int64(5))
+
+	// Run uses the variables of the previous run
+	compiledRun(t, c)
+	compiledGet(t, c, "a", int64(2))
+
+	// ResetAndRun restores the variables
+	assert.NoError(t, c.ResetAndRun())
+	compiledGet(t, c, "a", int64(1))
+	compiledGet(t, c, "b", int64(2))
+	assert.NoError(t, c.ResetAndRun())
+	compiledGet(t, c, "a", int64(1))
+
+	// the values set with Set are kept, and the other variables are cleared
+	assert.NoError(t, c.Set("b", -1))
+	assert.Error(t, c.ResetAndRun()) // assertion failed
+	compiledGet(t, c, "a", int64(-1))
+	compiledIsDefined(t, c, "d", false)
+}
+
+func TestCompiled_ManyGlobals(t *testing.T) {
+	// more globals than runtime.GlobalsSize
+	c := compile(t, string(manyGlobals(2000)), M{"a": 1})
+	compiledRun(t, c)
+	compiledGet(t, c, "a", int64(1))
+	compiledGet(t, c, "a0", int64(0))
+	compiledGet(t, c, "a1999", int64(1999))
+
+	// block-level globals
+	c = compile(t, `if true { b := 2; out = b * 2 }; for i := 0; i < 2; i++ { c := i; out += c }`, M{"out": 0})
+	compiledRun(t, c)
+	compiledGet(t, c, "out", int64(5))
+	assert.NoError(t, c.ResetAndRun())
+	compiledGet(t, c, "out", int64(5))
+}
+
+func TestCompiled_ConstCache(t *testing.T) {
+	// the times in different locations are not the same constants
+	utc := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	c := compile(t, `a := const_array(local); b := const_array(utc); s1 := same(a, b); s2 := same(local, utc); e := a == b`,
+		M{"utc": utc, "local": utc.In(time.FixedZone("KST", 9*60*60))})
+	compiledRun(t, c)
+	compiledGet(t, c, "s1", false)
+	compiledGet(t, c, "s2", false)
+	compiledGet(t, c, "e", true)
+
+	// the constants are cached per compiled script
+	c1 := compile(t, `a := const_array(1, 2)`, nil)
+	c2 := compile(t, `a := const_array(1, 2)`, nil)
+	compiledRun(t, c1)
+	compiledRun(t, c2)
+	assert.True(t, c1.Get("a").Object() != c2.Get("a").Object())
+	a := c1.Get("a").Object()
+	compiledRun(t, c1)
+	assert.True(t, c1.Get("a").Object() == a)
+}
+
+func BenchmarkCompiled_Run(b *testing.B) {
+	c := compileBenchmarkScript(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := c.Run(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCompiled_ResetAndRun(b *testing.B) {
+	c := compileBenchmarkScript(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := c.ResetAndRun(); err != nil {
+			b.Fatal(err)
+		}
+	}
 }
 
+func compileBenchmarkScript(b *testing.B) *script.Compiled {
+	s := script.New([]byte(benchmarkScript))
+	_ = s.Add("n", 10)
+	c, err := s.Compile()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return c
+}
+
+func BenchmarkScript_ManyGlobals(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := script.New(benchmarkManyGlobals).Run(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkScript_StringConcat(b *testing.B) {
+	benchmarkCompiledRun(b, `s := ""; for i := 0; i < 10000; i++ { s += "piece" }`)
+}
+
+func BenchmarkScript_StringBuilder(b *testing.B) {
+	benchmarkCompiledRun(b, `s := builder(); for i := 0; i < 10000; i++ { s.append("piece") }; s = s.string()`)
+}
+
+func benchmarkCompiledRun(b *testing.B, src string) {
+	c, err := script.New([]byte(src)).Compile()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := c.Run(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+var benchmarkManyGlobals = manyGlobals(500)
+
+func manyGlobals(n int) []byte {
+	var src bytes.Buffer
+	for i := 0; i < n; i++ {
+		_, _ = fmt.Fprintf(&src, "a%d := %d\n", i, i)
+	}
+
+	return src.Bytes()
+}
+
+const benchmarkScript = `
+sum := 0
+for i := 0; i < n; i++ {
+	sum += i
+}`
+
 func compile(t *testing.T, input string, vars M) *script.Compiled {
 	s := script.New([]byte(input))
 	for vn, vv := range vars {
@@ -0,0 +1,17 @@
+package script
+
+import "github.com/d5/tengo/compiler/parser"
+
+// ParseError is returned by Script.Compile if the script has syntax errors.
+type ParseError struct {
+	List parser.ErrorList
+}
+
+func (e *ParseError) Error() string {
+	return "parse error: " + e.List.Error()
+}
+
+// Errors returns all the syntax errors sorted by their positions.
+func (e *ParseError) Errors() []parser.Error {
+	return e.List.Errors()
+}
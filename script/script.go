@@ -3,6 +3,8 @@ package script
 import (
 	"context"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/d5/tengo/compiler"
 	"github.com/d5/tengo/compiler/parser"
@@ -19,14 +21,26 @@ type Script struct {
 	removedStdModules map[string]bool
 	scriptModules     map[string]*Script
 	userModuleLoader  compiler.ModuleLoader
+	enableGoroutines  bool
+	enableHTTP        bool
+	httpTimeout       time.Duration
+	httpMaxBodySize   int64
+	enableFileIO      bool
+	enableEval        bool
+	enableCoverage    bool
+	output            io.Writer
+	maxConstants      int
+	maxInstructions   int
 	input             []byte
 }
 
 // New creates a Script instance with an input script.
 func New(input []byte) *Script {
 	return &Script{
-		variables: make(map[string]*Variable),
-		input:     input,
+		variables:       make(map[string]*Variable),
+		httpTimeout:     stdlib.DefaultHTTPTimeout,
+		httpMaxBodySize: stdlib.DefaultHTTPMaxBodySize,
+		input:           input,
 	}
 }
 
@@ -66,6 +80,71 @@ func (s *Script) DisableBuiltinFunction(name string) {
 	s.removedBuiltins[name] = true
 }
 
+// EnableGoroutines enables or disables 'go' builtin function that runs
+// a function concurrently. It's disabled by default.
+func (s *Script) EnableGoroutines(enable bool) {
+	s.enableGoroutines = enable
+}
+
+// EnableHTTP enables or disables "http" module that makes HTTP requests.
+// It's disabled by default.
+func (s *Script) EnableHTTP(enable bool) {
+	s.enableHTTP = enable
+}
+
+// SetHTTPTimeout sets the timeout of the requests made by "http" module.
+// Default timeout is stdlib.DefaultHTTPTimeout.
+func (s *Script) SetHTTPTimeout(timeout time.Duration) {
+	s.httpTimeout = timeout
+}
+
+// SetHTTPMaxBodySize sets the maximum size of the response bodies read by
+// "http" module. A larger response is returned as an error object. Default
+// size is stdlib.DefaultHTTPMaxBodySize.
+func (s *Script) SetHTTPMaxBodySize(size int64) {
+	s.httpMaxBodySize = size
+}
+
+// EnableFileIO enables or disables "fs" module that reads and writes files.
+// It's disabled by default. Note that "os" module can access the files too:
+// use DisableStdModule("os") to disable it.
+func (s *Script) EnableFileIO(enable bool) {
+	s.enableFileIO = enable
+}
+
+// EnableEval enables or disables 'eval' builtin function that compiles and
+// runs a source string in a new VM. It's disabled by default.
+func (s *Script) EnableEval(enable bool) {
+	s.enableEval = enable
+}
+
+// EnableCoverage enables or disables recording the source lines of the
+// executed statements. Use Compiled.CoveredLines to get the lines after
+// the runs. It's disabled by default.
+func (s *Script) EnableCoverage(enable bool) {
+	s.enableCoverage = enable
+}
+
+// SetOutput sets the writer that the output functions (e.g. print) write
+// to. Default output is os.Stdout.
+func (s *Script) SetOutput(w io.Writer) {
+	s.output = w
+}
+
+// SetMaxConstants sets the maximum number of constants of the compiled
+// script. Compile fails if the script has more constants. Default is 0,
+// which means no limit.
+func (s *Script) SetMaxConstants(n int) {
+	s.maxConstants = n
+}
+
+// SetMaxInstructions sets the maximum number of instructions of the compiled
+// script. Compile fails if the script has more instructions. Default is 0,
+// which means no limit.
+func (s *Script) SetMaxInstructions(n int) {
+	s.maxInstructions = n
+}
+
 // DisableStdModule disables a standard library module.
 func (s *Script) DisableStdModule(name string) {
 	if s.removedStdModules == nil {
@@ -91,6 +170,7 @@ func (s *Script) AddModule(name string, scriptModule *Script) {
 }
 
 // Compile compiles the script with all the defined variables, and, returns Compiled object.
+// If the script has syntax errors, it returns *ParseError.
 func (s *Script) Compile() (*Compiled, error) {
 	symbolTable, stdModules, globals, err := s.prepCompile()
 	if err != nil {
@@ -98,14 +178,24 @@ func (s *Script) Compile() (*Compiled, error) {
 	}
 
 	fileSet := source.NewFileSet()
+	srcFile := fileSet.AddFile("", -1, len(s.input))
 
-	p := parser.NewParser(fileSet.AddFile("", -1, len(s.input)), s.input, nil)
+	p := parser.NewParser(srcFile, s.input, nil)
 	file, err := p.ParseFile()
 	if err != nil {
+		if list, ok := err.(parser.ErrorList); ok {
+			return nil, &ParseError{List: list}
+		}
+
 		return nil, fmt.Errorf("parse error: %s", err.Error())
 	}
 
 	c := compiler.NewCompiler(symbolTable, stdModules, nil)
+	c.SetMaxConstants(s.maxConstants)
+	c.SetMaxInstructions(s.maxInstructions)
+	if s.enableCoverage {
+		c.EnableCoverage(srcFile)
+	}
 
 	if s.userModuleLoader != nil {
 		c.SetModuleLoader(s.userModuleLoader)
@@ -115,12 +205,55 @@ func (s *Script) Compile() (*Compiled, error) {
 		return nil, err
 	}
 
+	// allocate the global variables for all the symbols of the script
+	numGlobals := symbolTable.MaxSymbols()
+	if numGlobals < len(globals) {
+		numGlobals = len(globals)
+	}
+	vmGlobals := make([]*objects.Object, numGlobals)
+	copy(vmGlobals, globals)
+
+	machine := runtime.NewVMWithGlobals(c.Bytecode(), vmGlobals)
+	if s.output != nil {
+		machine.SetOutput(s.output)
+	}
+	machine.SetEvalLimits(s.maxConstants, s.maxInstructions)
+	for _, fn := range objects.Builtins {
+		if !s.isBuiltinEnabled(fn.Name) {
+			machine.DisableEvalBuiltins(fn.Name)
+		}
+	}
+	if s.enableCoverage {
+		machine.EnableCoverage()
+	}
+
 	return &Compiled{
 		symbolTable: symbolTable,
-		machine:     runtime.NewVM(c.Bytecode(), globals),
+		machine:     machine,
+		inputs:      append([]*objects.Object(nil), globals...),
 	}, nil
 }
 
+// Check parses the script and resolves its symbols without compiling it
+// and returns the first error found along with its position. It does not
+// load or check the imported modules.
+func (s *Script) Check() error {
+	symbolTable := s.newSymbolTable()
+	for name := range s.variables {
+		symbolTable.Define(name)
+	}
+
+	fileSet := source.NewFileSet()
+
+	p := parser.NewParser(fileSet.AddFile("", -1, len(s.input)), s.input, nil)
+	file, err := p.ParseFile()
+	if err != nil {
+		return err
+	}
+
+	return compiler.Check(file, symbolTable)
+}
+
 // Run compiles and runs the scripts.
 // Use returned compiled object to access global variables.
 func (s *Script) Run() (compiled *Compiled, err error) {
@@ -146,18 +279,25 @@ func (s *Script) RunContext(ctx context.Context) (compiled *Compiled, err error)
 	return
 }
 
+// newSymbolTable creates a symbol table with the enabled builtin functions.
+func (s *Script) newSymbolTable() *compiler.SymbolTable {
+	symbolTable := compiler.NewSymbolTable()
+	for idx, fn := range objects.Builtins {
+		if s.isBuiltinEnabled(fn.Name) {
+			symbolTable.DefineBuiltin(idx, fn.Name)
+		}
+	}
+
+	return symbolTable
+}
+
 func (s *Script) prepCompile() (symbolTable *compiler.SymbolTable, stdModules map[string]*objects.ImmutableMap, globals []*objects.Object, err error) {
 	var names []string
 	for name := range s.variables {
 		names = append(names, name)
 	}
 
-	symbolTable = compiler.NewSymbolTable()
-	for idx, fn := range objects.Builtins {
-		if !s.removedBuiltins[fn.Name] {
-			symbolTable.DefineBuiltin(idx, fn.Name)
-		}
-	}
+	symbolTable = s.newSymbolTable()
 
 	stdModules = make(map[string]*objects.ImmutableMap)
 	for name, mod := range stdlib.Modules {
@@ -165,6 +305,15 @@ func (s *Script) prepCompile() (symbolTable *compiler.SymbolTable, stdModules ma
 			stdModules[name] = mod
 		}
 	}
+	if !s.removedStdModules["rand"] {
+		stdModules["rand"] = stdlib.RandModule()
+	}
+	if s.enableHTTP && !s.removedStdModules["http"] {
+		stdModules["http"] = stdlib.HTTPModule(s.httpTimeout, s.httpMaxBodySize)
+	}
+	if s.enableFileIO && !s.removedStdModules["fs"] {
+		stdModules["fs"] = stdlib.FSModule
+	}
 	for name, scriptModule := range s.scriptModules {
 		if scriptModule == nil {
 			err = fmt.Errorf("script module must not be nil: %s", name)
@@ -212,6 +361,23 @@ func (s *Script) prepCompile() (symbolTable *compiler.SymbolTable, stdModules ma
 	return
 }
 
+// isBuiltinEnabled returns false if the builtin function is disabled
+// or if it's an opt-in builtin function that is not enabled.
+func (s *Script) isBuiltinEnabled(name string) bool {
+	if s.removedBuiltins[name] {
+		return false
+	}
+
+	switch name {
+	case "go":
+		return s.enableGoroutines
+	case "eval":
+		return s.enableEval
+	}
+
+	return true
+}
+
 func (s *Script) copyVariables() map[string]*Variable {
 	vars := make(map[string]*Variable)
 	for n, v := range s.variables {
@@ -1,10 +1,22 @@
 package script_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/d5/tengo/assert"
+	"github.com/d5/tengo/compiler"
+	"github.com/d5/tengo/objects"
 	"github.com/d5/tengo/script"
 )
 
@@ -49,6 +61,403 @@ func TestScript_DisableBuiltinFunction(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestScript_EnableGoroutines(t *testing.T) {
+	s := script.New([]byte(`
+f := func(a, b) { return a + b }
+t1 := go(f, 1, 2)
+t2 := go(func(n) { s := 0; for i := 1; i <= n; i++ { s += i }; return s }, 100)
+a := t1.await() + t2.await()`))
+	_, err := s.Run()
+	assert.Error(t, err) // disabled by default
+
+	s.EnableGoroutines(true)
+	c, err := s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "a", int64(5053))
+
+	// error from the goroutine surfaces on await
+	s = script.New([]byte(`t := go(func() { return 1 + "a" }); a := t.await()`))
+	s.EnableGoroutines(true)
+	_, err = s.Run()
+	assert.Error(t, err)
+
+	s.EnableGoroutines(false)
+	_, err = s.Run()
+	assert.Error(t, err)
+
+	// goroutines are aborted when the script ends
+	var ticks int64
+	s = script.New([]byte(`go(func() { for { tick() } })`))
+	s.EnableGoroutines(true)
+	_ = s.Add("tick", &objects.UserFunction{Value: func(args ...objects.Object) (objects.Object, error) {
+		atomic.AddInt64(&ticks, 1)
+		return objects.UndefinedValue, nil
+	}})
+	_, err = s.Run()
+	assert.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	n := atomic.LoadInt64(&ticks)
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, n, atomic.LoadInt64(&ticks))
+}
+
+func TestScript_EnableCoverage(t *testing.T) {
+	src := []byte(`a := 1
+if a > 5 {
+	a = 10
+	a++
+} else {
+	a = 20
+}
+f := func(x) {
+	return x * 2
+}
+if a < 0 { a = f(a) }
+b := f(a)`)
+
+	s := script.New(src)
+	c, err := s.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(c.CoveredLines())) // disabled by default
+
+	s.EnableCoverage(true)
+	c, err = s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "b", int64(40))
+	assert.Equal(t, []int{1, 2, 6, 8, 9, 11, 12}, c.CoveredLines())
+
+	// the lines accumulate over the runs
+	s = script.New([]byte("if n > 0 {\n\tn = 1\n} else {\n\tn = 2\n}"))
+	s.EnableCoverage(true)
+	assert.NoError(t, s.Add("n", 1))
+	c, err = s.Compile()
+	assert.NoError(t, err)
+	assert.NoError(t, c.Run())
+	assert.Equal(t, []int{1, 2}, c.CoveredLines())
+	assert.NoError(t, c.Set("n", 0))
+	assert.NoError(t, c.Run())
+	assert.Equal(t, []int{1, 2, 4}, c.CoveredLines())
+}
+
+func TestScript_EnableEval(t *testing.T) {
+	s := script.New([]byte(`a := eval("1 + 2 * 3")`))
+	_, err := s.Run()
+	assert.Error(t, err) // disabled by default
+
+	s.EnableEval(true)
+	c, err := s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "a", int64(7))
+
+	// no access to the globals of the script
+	s = script.New([]byte(`a := is_error(eval("b * 2"))`))
+	s.EnableEval(true)
+	assert.NoError(t, s.Add("b", 5))
+	c, err = s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "a", true)
+
+	// the builtin functions disabled in the script are disabled in eval too
+	s = script.New([]byte(`a := is_error(eval("print(1)")); b := eval("len([1, 2])")`))
+	s.EnableEval(true)
+	s.DisableBuiltinFunction("print")
+	c, err = s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "a", true)
+	compiledGet(t, c, "b", int64(2))
+
+	// compile limits apply to the evaluated source
+	s = script.New([]byte(`a := is_error(eval("[1, 2, 3, 4, 5]"))`))
+	s.EnableEval(true)
+	s.SetMaxConstants(3)
+	c, err = s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "a", true)
+
+	// the evaluated source is aborted along with the script
+	s = script.New([]byte(`eval("for {}")`))
+	s.EnableEval(true)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.RunContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestScript_EnableHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow":
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("X-Method", r.Method)
+		w.Header().Set("X-Content-Type", r.Header.Get("Content-Type"))
+		_, _ = w.Write(append([]byte(r.Header.Get("X-Token")+":"), body...))
+	}))
+	defer server.Close()
+
+	s := script.New([]byte(`
+http := import("http")
+r := http.get(url)
+status := r.status
+method := r.headers["X-Method"]
+body := r.body`))
+	_ = s.Add("url", server.URL)
+	_, err := s.Run()
+	assert.Error(t, err) // disabled by default
+
+	s.EnableHTTP(true)
+	c, err := s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "status", int64(200))
+	compiledGet(t, c, "method", "GET")
+	compiledGet(t, c, "body", []byte(":"))
+
+	// post
+	s = script.New([]byte(`
+http := import("http")
+r := http.post(url, "hello", "text/plain")
+method := r.headers["X-Method"]
+contentType := r.headers["X-Content-Type"]
+body := string(r.body)`))
+	s.EnableHTTP(true)
+	_ = s.Add("url", server.URL)
+	c, err = s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "method", "POST")
+	compiledGet(t, c, "contentType", "text/plain")
+	compiledGet(t, c, "body", ":hello")
+
+	// request with headers
+	s = script.New([]byte(`
+http := import("http")
+headers := {}
+headers["X-Token"] = "abc"
+r1 := http.request("put", url, headers, bytes("data"))
+r2 := http.request("DELETE", url + "/missing")
+method := r1.headers["X-Method"]
+body := string(r1.body)
+status := r2.status`))
+	s.EnableHTTP(true)
+	_ = s.Add("url", server.URL)
+	c, err = s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "method", "PUT")
+	compiledGet(t, c, "body", "abc:data")
+	compiledGet(t, c, "status", int64(404))
+
+	// response body larger than the limit
+	s = script.New([]byte(`
+http := import("http")
+r1 := http.post(url, "abc", "text/plain")
+r2 := http.post(url, "abcd", "text/plain")
+body := string(r1.body)
+tooLarge := is_error(r2)`))
+	s.EnableHTTP(true)
+	s.SetHTTPMaxBodySize(4)
+	_ = s.Add("url", server.URL)
+	c, err = s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "body", ":abc")
+	compiledGet(t, c, "tooLarge", true)
+
+	// connection error and timeout are returned as error objects
+	s = script.New([]byte(`
+http := import("http")
+connErr := is_error(http.get("http://127.0.0.1:0"))
+timeoutErr := is_error(http.get(url + "/slow"))`))
+	s.EnableHTTP(true)
+	s.SetHTTPTimeout(10 * time.Millisecond)
+	_ = s.Add("url", server.URL)
+	c, err = s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "connErr", true)
+	compiledGet(t, c, "timeoutErr", true)
+
+	// canceled by the context
+	s = script.New([]byte(`http := import("http"); r := http.get(url + "/slow")`))
+	s.EnableHTTP(true)
+	_ = s.Add("url", server.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.RunContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	s = script.New([]byte(`http := import("http"); http.get()`))
+	s.EnableHTTP(true)
+	_, err = s.Run()
+	assert.Error(t, err)
+}
+
+func TestScript_EnableFileIO(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tengo-script")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	s := script.New([]byte(`
+fs := import("fs")
+fs.write_file(file, "data")
+data := string(fs.read_file(file))`))
+	_ = s.Add("file", filepath.Join(dir, "a.txt"))
+	_, err = s.Run()
+	assert.Error(t, err) // disabled by default
+
+	s.EnableFileIO(true)
+	c, err := s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "data", "data")
+
+	s.DisableStdModule("fs")
+	_, err = s.Run()
+	assert.Error(t, err)
+
+	// "os" module can access the files regardless of the flag
+	s = script.New([]byte(`os := import("os"); err := os.remove(file)`))
+	_ = s.Add("file", filepath.Join(dir, "a.txt"))
+	_, err = s.Run()
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "a.txt"))
+	assert.True(t, os.IsNotExist(err))
+
+	s.DisableStdModule("os")
+	_, err = s.Run()
+	assert.Error(t, err)
+}
+
+func TestScript_ParseError(t *testing.T) {
+	_, err := script.New([]byte("a := )\nb := )\nc := 3")).Compile()
+	assert.Error(t, err)
+	perr, ok := err.(*script.ParseError)
+	assert.True(t, ok)
+	assert.True(t, strings.HasPrefix(err.Error(), "parse error: "))
+	errs := perr.Errors()
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, 1, errs[0].Pos.Line)
+	assert.Equal(t, 2, errs[1].Pos.Line)
+
+	// a valid script
+	_, err = script.New([]byte(`a := 1`)).Compile()
+	assert.NoError(t, err)
+}
+
+func TestScript_SetOutput(t *testing.T) {
+	var buf bytes.Buffer
+	s := script.New([]byte(`
+print("hello", 1)
+printf("%d-%s\n", 2, "x")
+a := [0, {c: "d", b: immutable([])}]
+a[0] = a
+b := dump(a)[1].c
+f := func() { dump(ordered_map()) }
+f()`))
+	s.SetOutput(&buf)
+	c, err := s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "b", "d")
+	assert.Equal(t, `hello
+1
+2-x
+[
+  <cycle>,
+  {
+    b: [],
+    c: "d"
+  }
+]
+{}
+`, buf.String())
+}
+
+func TestScript_Assert(t *testing.T) {
+	c, err := script.New([]byte(`a := assert(1 < 2, "math works")`)).Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "a", nil)
+
+	_, err = script.New([]byte(`assert(len([]) > 0, "no items"); a := 1`)).Run()
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "assertion failed: no items"), err.Error())
+
+	_, err = script.New([]byte(`assert(undefined)`)).Run()
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "assertion failed"), err.Error())
+}
+
+func TestScript_SetMaxConstants(t *testing.T) {
+	s := script.New([]byte(`a := [1, 2, 3, 4, 5]`))
+	s.SetMaxConstants(5)
+	c, err := s.Compile()
+	assert.NoError(t, err)
+	assert.NotNil(t, c)
+
+	s = script.New([]byte(`a := [1, 2, 3, 4, 5, 6]`))
+	s.SetMaxConstants(5)
+	_, err = s.Compile()
+	assert.Error(t, err)
+	assert.Equal(t, "too many constants: limit is 5", err.Error())
+
+	// constants of the functions and modules are counted
+	s = script.New([]byte(`mod := import("mod"); f := func() { return [4, 5, 6] }`))
+	s.SetUserModuleLoader(func(moduleName string) ([]byte, error) {
+		return []byte(`export [1, 2, 3]`), nil
+	})
+	s.SetMaxConstants(5)
+	_, err = s.Compile()
+	assert.Error(t, err)
+}
+
+func TestScript_SetMaxInstructions(t *testing.T) {
+	s := script.New([]byte(`a := 1 + 2`))
+	s.SetMaxInstructions(10)
+	_, err := s.Compile()
+	assert.NoError(t, err)
+
+	s = script.New([]byte(`a := 1; for i := 0; i < 10; i++ { a += i }`))
+	s.SetMaxInstructions(10)
+	_, err = s.Compile()
+	assert.Error(t, err)
+	assert.Equal(t, "too many instructions: limit is 10", err.Error())
+}
+
+func TestScript_Check(t *testing.T) {
+	s := script.New([]byte(`
+fmt := import("text")
+a := [1, 2, 3]
+f := func(x) { return x * b }
+for i, v in a { f(v) }`))
+	assert.NoError(t, s.Add("b", 2))
+	assert.NoError(t, s.Check())
+
+	s = script.New([]byte(`a := 1
+b := a + c`))
+	err := s.Check()
+	assert.Error(t, err)
+	assert.Equal(t, "2:10: undefined variable: c", err.Error())
+	cerr, ok := err.(*compiler.Error)
+	assert.True(t, ok)
+	assert.Equal(t, 2, cerr.Pos.Line)
+	assert.Equal(t, 10, cerr.Pos.Column)
+
+	// syntax error
+	err = script.New([]byte(`a := [1, 2`)).Check()
+	assert.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "1:11:"), err.Error())
+
+	// disabled builtin
+	s = script.New([]byte(`a := len([])`))
+	s.DisableBuiltinFunction("len")
+	assert.Error(t, s.Check())
+}
+
 func TestScript_DisableStdModule(t *testing.T) {
 	s := script.New([]byte(`math := import("math"); a := math.abs(-19.84)`))
 	c, err := s.Run()
@@ -60,6 +469,29 @@ func TestScript_DisableStdModule(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestScript_RandModule(t *testing.T) {
+	src := `rand := import("rand"); rand.seed(42); a := rand.int(); other(); b := rand.int()`
+
+	// the reference run
+	s := script.New([]byte(src))
+	assert.NoError(t, s.Add("other", &objects.UserFunction{Value: func(args ...objects.Object) (objects.Object, error) {
+		return objects.UndefinedValue, nil
+	}}))
+	want, err := s.Run()
+	assert.NoError(t, err)
+
+	// another script seeding and using its module in between
+	s = script.New([]byte(src))
+	assert.NoError(t, s.Add("other", &objects.UserFunction{Value: func(args ...objects.Object) (objects.Object, error) {
+		_, err := script.New([]byte(`rand := import("rand"); rand.seed(1); rand.int(); rand.int()`)).Run()
+		return objects.UndefinedValue, err
+	}}))
+	c, err := s.Run()
+	assert.NoError(t, err)
+	compiledGet(t, c, "a", want.Get("a").Int64())
+	compiledGet(t, c, "b", want.Get("b").Int64())
+}
+
 func TestScript_SetUserModuleLoader(t *testing.T) {
 	s := script.New([]byte(`math := import("mod1"); a := math.foo()`))
 	_, err := s.Run()